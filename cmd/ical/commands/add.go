@@ -45,7 +45,7 @@ var addCmd = &cobra.Command{
 			if len(addInvite) > 0 || addTravel != "" {
 				return fmt.Errorf("--invite and --travel are not supported in interactive mode (-i); pass them on the non-interactive command line")
 			}
-			return runAddInteractive()
+			return runAddInteractive("")
 		}
 
 		title := addTitle
@@ -60,19 +60,23 @@ var addCmd = &cobra.Command{
 			return fmt.Errorf("--start is required")
 		}
 
-		startTime, err := dateparser.ParseDate(addStart)
+		startTime, err := parseDate(addStart)
 		if err != nil {
 			return fmt.Errorf("invalid --start date: %w", err)
 		}
 
 		endTime := startTime.Add(time.Hour)
 		if addEnd != "" {
-			endTime, err = dateparser.ParseDate(addEnd)
+			endTime, err = parseDate(addEnd)
 			if err != nil {
 				return fmt.Errorf("invalid --end date: %w", err)
 			}
 		}
 
+		if addAllDay {
+			startTime, endTime = floatingTime(startTime), floatingTime(endTime)
+		}
+
 		if addTimezone != "" {
 			loc, err := time.LoadLocation(addTimezone)
 			if err != nil {
@@ -231,7 +235,9 @@ func init() {
 	rootCmd.AddCommand(addCmd)
 }
 
-func runAddInteractive() error {
+// runAddInteractive runs the add form. start pre-fills the Start field
+// (e.g. the day selected in the TUI); empty leaves it blank.
+func runAddInteractive(start string) error {
 	client, err := calendar.New()
 	if err != nil {
 		return handleClientError(err)
@@ -250,7 +256,7 @@ func runAddInteractive() error {
 	var (
 		title    string
 		calName  string
-		startStr string
+		startStr = start
 		endStr   string
 		allDay   bool
 		location string
@@ -287,7 +293,7 @@ func runAddInteractive() error {
 				if strings.TrimSpace(s) == "" {
 					return fmt.Errorf("start date is required")
 				}
-				_, err := dateparser.ParseDate(s)
+				_, err := parseDate(s)
 				if err != nil {
 					return fmt.Errorf("invalid date: %v", err)
 				}
@@ -303,7 +309,7 @@ func runAddInteractive() error {
 				if strings.TrimSpace(s) == "" {
 					return nil
 				}
-				_, err := dateparser.ParseDate(s)
+				_, err := parseDate(s)
 				if err != nil {
 					return fmt.Errorf("invalid date: %v", err)
 				}
@@ -392,14 +398,17 @@ func runAddInteractive() error {
 	}
 
 	// Build event input
-	startTime, _ := dateparser.ParseDate(startStr) // validated above
+	startTime, _ := parseDate(startStr) // validated above
 
 	endTime := startTime.Add(time.Hour)
 	if allDay {
 		endTime = time.Date(startTime.Year(), startTime.Month(), startTime.Day()+1,
 			0, 0, 0, 0, startTime.Location())
 	} else if strings.TrimSpace(endStr) != "" {
-		endTime, _ = dateparser.ParseDate(endStr) // validated above
+		endTime, _ = parseDate(endStr) // validated above
+	}
+	if allDay {
+		startTime, endTime = floatingTime(startTime), floatingTime(endTime)
 	}
 
 	if tz != "" {
@@ -504,7 +513,7 @@ func buildRecurrenceRule(timezone string) (eventkit.RecurrenceRule, error) {
 	}
 
 	if addRepeatUntil != "" {
-		t, err := dateparser.ParseDate(addRepeatUntil)
+		t, err := parseDate(addRepeatUntil)
 		if err != nil {
 			return rule, fmt.Errorf("invalid --repeat-until: %w", err)
 		}
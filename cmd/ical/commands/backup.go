@@ -0,0 +1,121 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/ical/internal/backup"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var (
+	backupFrom      string
+	backupTo        string
+	backupCalendars []string
+)
+
+var backupCmd = &cobra.Command{
+	Use:   "backup [file]",
+	Short: "Back up all calendars to an archive",
+	Long: `Writes every calendar's metadata and events to a single archive, with a
+manifest of counts and time ranges. The format follows the file extension:
+.tar.gz/.tgz or .zip. Defaults to ical-backup-<date>.tar.gz in the current
+directory, covering two years back and two years ahead.
+
+Restore with 'ical restore <file>'.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		now := time.Now()
+		filename := fmt.Sprintf("ical-backup-%s.tar.gz", now.Format("2006-01-02"))
+		if len(args) > 0 {
+			filename = args[0]
+		}
+
+		from := startOfDay(now.AddDate(-2, 0, 0))
+		if backupFrom != "" {
+			t, err := parseDate(backupFrom)
+			if err != nil {
+				return fmt.Errorf("invalid --from date: %w", err)
+			}
+			from = t
+		}
+
+		to := startOfDay(now.AddDate(2, 0, 1))
+		if backupTo != "" {
+			t, err := parseDate(backupTo)
+			if err != nil {
+				return fmt.Errorf("invalid --to date: %w", err)
+			}
+			to = endOfDayIfMidnight(t)
+		}
+
+		client, err := calendar.New()
+		if err != nil {
+			return handleClientError(err)
+		}
+
+		calendars, err := client.Calendars()
+		if err != nil {
+			return fmt.Errorf("failed to fetch calendars: %w", err)
+		}
+		events, err := client.Events(from, to)
+		if err != nil {
+			return fmt.Errorf("failed to fetch events: %w", err)
+		}
+
+		if len(backupCalendars) > 0 {
+			calendars = includeCalendars(calendars, backupCalendars)
+			events = filterIncludedCalendars(events, backupCalendars)
+		}
+
+		b := backup.New(calendars, events, from, to)
+
+		// Keep where each recurring series starts, which may be before
+		// --from, so a restore does not restart a COUNT or UNTIL limited
+		// series at its first backed-up occurrence.
+		yellow := color.New(color.FgYellow)
+		for _, id := range b.RecurringIDs() {
+			first, err := client.Event(id)
+			if err != nil {
+				yellow.Fprintf(os.Stderr, "Warning: failed to fetch the start of series %s: %v\n", id, err)
+				continue
+			}
+			b.AddSeries(*first)
+		}
+
+		if err := backup.Write(b, filename); err != nil {
+			return err
+		}
+
+		green := color.New(color.FgGreen, color.Bold)
+		green.Printf("Backed up %d events from %d calendars to %s\n", b.Manifest.Events, len(b.Calendars), filename)
+		return nil
+	},
+}
+
+func init() {
+	backupCmd.Flags().StringVarP(&backupFrom, "from", "f", "", "Start date (default: 2 years ago)")
+	backupCmd.Flags().StringVarP(&backupTo, "to", "t", "", "End date (default: 2 years ahead)")
+	backupCmd.Flags().StringArrayVarP(&backupCalendars, "calendar", "c", nil, "Only back up these calendars (repeatable)")
+
+	rootCmd.AddCommand(backupCmd)
+}
+
+// includeCalendars keeps the calendars whose title matches one of names
+// after normalization.
+func includeCalendars(calendars []calendar.Calendar, names []string) []calendar.Calendar {
+	want := make(map[string]bool, len(names))
+	for _, n := range normalizeCalendarNames(names) {
+		want[n] = true
+	}
+	var out []calendar.Calendar
+	for _, c := range calendars {
+		if want[normalizeCalendarName(c.Title)] {
+			out = append(out, c)
+		}
+	}
+	return out
+}
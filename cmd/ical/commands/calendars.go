@@ -56,8 +56,7 @@ var calendarsListCmd = &cobra.Command{
 			return fmt.Errorf("failed to list calendars: %w", err)
 		}
 
-		ui.PrintCalendars(cals, outputFormat)
-		return nil
+		return ui.PrintCalendars(cals, outputFormat)
 	},
 }
 
@@ -5,10 +5,9 @@ import (
 	"fmt"
 	"os"
 	"strings"
-	"time"
 
-	"github.com/BRO3886/go-eventkit/dateparser"
 	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/ical/internal/ui"
 	"github.com/charmbracelet/huh"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -84,7 +83,7 @@ occurrence), future (this and later occurrences), or all (the whole series).`,
 		if !deleteForce {
 			red := color.New(color.FgRed, color.Bold)
 			red.Printf("Delete event: ")
-			fmt.Printf("%s (%s)\n", event.Title, event.StartDate.Format("Jan 02 15:04"))
+			fmt.Printf("%s (%s)\n", event.Title, ui.CurrentLocale().DateTime(ui.DisplayTime(event.StartDate, event.AllDay)))
 
 			fmt.Print("Are you sure? [y/N] ")
 			reader := bufio.NewReader(os.Stdin)
@@ -126,7 +125,7 @@ func runBatchDelete(client *calendar.Client, args []string, span calendar.Span)
 		red := color.New(color.FgRed, color.Bold)
 		red.Printf("Delete %d events:\n", len(events))
 		for _, e := range events {
-			fmt.Printf("  - %s (%s)\n", e.Title, e.StartDate.Format("Jan 02 15:04"))
+			fmt.Printf("  - %s (%s)\n", e.Title, ui.CurrentLocale().DateTime(ui.DisplayTime(e.StartDate, e.AllDay)))
 		}
 
 		fmt.Print("Are you sure? [y/N] ")
@@ -215,11 +214,11 @@ func init() {
 // pickEvent shows an interactive huh.Select picker for events in a date range.
 // Returns nil, nil if user cancelled.
 func pickEvent(client *calendar.Client, fromStr, toStr string, days int) (*calendar.Event, error) {
-	now := time.Now()
+	now := displayNow()
 
 	from := startOfDay(now)
 	if fromStr != "" {
-		t, err := dateparser.ParseDate(fromStr)
+		t, err := parseDate(fromStr)
 		if err != nil {
 			return nil, fmt.Errorf("invalid --from date: %w", err)
 		}
@@ -228,7 +227,7 @@ func pickEvent(client *calendar.Client, fromStr, toStr string, days int) (*calen
 
 	to := from.AddDate(0, 0, days)
 	if toStr != "" {
-		t, err := dateparser.ParseDate(toStr)
+		t, err := parseDate(toStr)
 		if err != nil {
 			return nil, fmt.Errorf("invalid --to date: %w", err)
 		}
@@ -249,7 +248,7 @@ func pickEvent(client *calendar.Client, fromStr, toStr string, days int) (*calen
 	for i, e := range events {
 		start := localizeEventTime(e.StartDate, e.TimeZone)
 		end := localizeEventTime(e.EndDate, e.TimeZone)
-		label := fmt.Sprintf("%s  %s (%s)", dateparser.FormatTimeRange(start, end, e.AllDay), e.Title, e.Calendar)
+		label := fmt.Sprintf("%s  %s (%s)", ui.CurrentLocale().TimeRange(start, end, e.AllDay), e.Title, e.Calendar)
 		options[i] = huh.NewOption(label, e.ID)
 	}
 
@@ -0,0 +1,225 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/ical/internal/diff"
+	"github.com/BRO3886/ical/internal/export"
+	"github.com/BRO3886/ical/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	diffFrom   string
+	diffTo     string
+	diffFilter eventFilter
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff <old-export> [new-export]",
+	Short: "Show what changed between two exports, or an export and now",
+	Long: `Compares two exports made with 'ical export', or an export against the
+live calendar when only one file is given, and lists the events that were
+added, removed or modified, with the fields that changed.
+
+Events are matched by ID (the UID in ICS exports), so moved and renamed
+events show up as modified; events without a matching ID are matched by
+title and start time. JSON, CSV and ICS exports can be compared. CSV
+exports carry no attendees, so attendee changes are only reported between
+JSON and ICS exports and the live calendar.
+
+The comparison covers the time span of the events in the old export, or
+the span both exports cover when two are given; --from and --to override
+it. Against the live calendar only the calendars that appear in the export
+are compared, unless --calendar names others. The other filter flags apply
+to both sides alike.`,
+	Example: `  ical export -c Team --output-file team.json
+  ical diff team.json                      # what changed since the export
+  ical diff last-week.ics this-week.ics
+  ical diff team.json -f today -t "in 2 weeks" -o json`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		old, oldFormat, err := readSnapshot(args[0])
+		if err != nil {
+			return err
+		}
+
+		var cur []calendar.Event
+		newFormat, newLabel := "", "calendar"
+		if len(args) == 2 {
+			cur, newFormat, err = readSnapshot(args[1])
+			if err != nil {
+				return err
+			}
+			newLabel = filepath.Base(args[1])
+		}
+
+		from, to, err := diffRange(old, cur, len(args) == 2)
+		if err != nil {
+			return err
+		}
+
+		filter := diffFilter
+		if len(args) == 1 {
+			if len(filter.calendars) == 0 && filter.calendarID == "" {
+				filter.calendars = snapshotCalendars(old)
+			}
+			client, err := calendar.New()
+			if err != nil {
+				return handleClientError(err)
+			}
+			cur, err = client.Events(from, to)
+			if err != nil {
+				return fmt.Errorf("failed to list events: %w", err)
+			}
+		}
+
+		old = diffSelect(old, from, to, &filter)
+		cur = diffSelect(cur, from, to, &filter)
+
+		// CSV has no attendee column; drop them from the other side too so
+		// every attendee doesn't show up as added or removed.
+		if oldFormat == "csv" || newFormat == "csv" {
+			old, cur = withoutAttendees(old), withoutAttendees(cur)
+		}
+
+		result := diff.Compare(old, cur)
+		loc := ui.CurrentLocale()
+		title := fmt.Sprintf("%s → %s · %s – %s", filepath.Base(args[0]), newLabel,
+			loc.Date(from, true), loc.Date(to.Add(-time.Nanosecond), true))
+		ui.PrintDiff(result, title, outputFormat)
+		return nil
+	},
+}
+
+func init() {
+	diffCmd.Flags().StringVarP(&diffFrom, "from", "f", "", "Start date (default: start of the export)")
+	diffCmd.Flags().StringVarP(&diffTo, "to", "t", "", "End date (default: end of the export)")
+	diffFilter.addFlags(diffCmd, true)
+
+	rootCmd.AddCommand(diffCmd)
+}
+
+// readSnapshot reads an export file, picking the format from its
+// extension.
+func readSnapshot(filename string) ([]calendar.Event, string, error) {
+	format := strings.TrimPrefix(strings.ToLower(filepath.Ext(filename)), ".")
+	switch format {
+	case "json", "csv", "ics":
+	default:
+		return nil, "", fmt.Errorf("unsupported file format %q (use .json, .csv, or .ics)", filepath.Ext(filename))
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	events, err := export.ReadSnapshot(format, f)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse %s: %w", filename, err)
+	}
+	return events, format, nil
+}
+
+// diffRange is the window compared: --from and --to where given, else the
+// span of the old export, narrowed to the new one's when both are files.
+func diffRange(old, cur []calendar.Event, twoFiles bool) (time.Time, time.Time, error) {
+	from, to, ok := eventSpan(old)
+	if twoFiles {
+		if nf, nt, nok := eventSpan(cur); nok {
+			if ok {
+				from, to = maxTime(from, nf), minTime(to, nt)
+			} else {
+				from, to, ok = nf, nt, true
+			}
+		}
+	}
+
+	if diffFrom != "" {
+		t, err := parseDate(diffFrom)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --from date: %w", err)
+		}
+		from = t
+	}
+	if diffTo != "" {
+		t, err := parseDate(diffTo)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --to date: %w", err)
+		}
+		to = endOfDayIfMidnight(t)
+	}
+	if !ok && (diffFrom == "" || diffTo == "") {
+		return time.Time{}, time.Time{}, fmt.Errorf("the export has no events; pass --from and --to")
+	}
+	if !to.After(from) {
+		return time.Time{}, time.Time{}, fmt.Errorf("the exports cover no common time; pass --from and --to")
+	}
+	return from, to, nil
+}
+
+// eventSpan is the earliest start and latest end of events.
+func eventSpan(events []calendar.Event) (time.Time, time.Time, bool) {
+	if len(events) == 0 {
+		return time.Time{}, time.Time{}, false
+	}
+	from, to := events[0].StartDate, events[0].EndDate
+	for _, e := range events[1:] {
+		from, to = minTime(from, e.StartDate), maxTime(to, e.EndDate)
+	}
+	return from.In(ui.DisplayLocation()), to.In(ui.DisplayLocation()), true
+}
+
+func minTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// snapshotCalendars lists the calendar names that appear in events.
+func snapshotCalendars(events []calendar.Event) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, e := range events {
+		if e.Calendar != "" && !seen[e.Calendar] {
+			seen[e.Calendar] = true
+			names = append(names, e.Calendar)
+		}
+	}
+	return names
+}
+
+// diffSelect keeps the events that overlap [from, to) and pass the filter.
+func diffSelect(events []calendar.Event, from, to time.Time, filter *eventFilter) []calendar.Event {
+	var out []calendar.Event
+	for _, e := range events {
+		if e.StartDate.Before(to) && (e.EndDate.After(from) || !e.StartDate.Before(from)) {
+			out = append(out, e)
+		}
+	}
+	return filter.applyAll(out)
+}
+
+func withoutAttendees(events []calendar.Event) []calendar.Event {
+	out := make([]calendar.Event, len(events))
+	for i, e := range events {
+		e.Attendees = nil
+		out[i] = e
+	}
+	return out
+}
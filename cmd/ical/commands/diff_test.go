@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+func TestDiffRange(t *testing.T) {
+	at := func(day int) time.Time { return time.Date(2026, 3, day, 9, 0, 0, 0, time.Local) }
+	span := func(from, to int) []calendar.Event {
+		return []calendar.Event{{StartDate: at(from), EndDate: at(from).Add(time.Hour)}, {StartDate: at(to), EndDate: at(to).Add(time.Hour)}}
+	}
+
+	tests := []struct {
+		name     string
+		old, cur []calendar.Event
+		twoFiles bool
+		from, to time.Time
+		wantErr  bool
+	}{
+		{"old export against live", span(2, 20), nil, false, at(2), at(20).Add(time.Hour), false},
+		{"two exports overlap", span(2, 20), span(9, 27), true, at(9), at(20).Add(time.Hour), false},
+		{"empty new export", span(2, 20), nil, true, at(2), at(20).Add(time.Hour), false},
+		{"no overlap", span(2, 3), span(9, 10), true, time.Time{}, time.Time{}, true},
+		{"no events", nil, nil, false, time.Time{}, time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to, err := diffRange(tt.old, tt.cur, tt.twoFiles)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got %v – %v", from, to)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !from.Equal(tt.from) || !to.Equal(tt.to) {
+				t.Errorf("range = %v – %v, want %v – %v", from, to, tt.from, tt.to)
+			}
+		})
+	}
+}
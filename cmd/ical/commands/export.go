@@ -3,31 +3,52 @@ package commands
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/BRO3886/ical/internal/export"
-	"github.com/BRO3886/go-eventkit/dateparser"
+	"github.com/BRO3886/ical/internal/ui"
 	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
 var (
 	exportFrom       string
 	exportTo         string
-	exportCalendars  []string
+	exportFilter     eventFilter
 	exportFormatFlag string
 	exportOutputFile string
+	exportSplit      string
+	exportOutputDir  string
 )
 
 var exportCmd = &cobra.Command{
 	Use:   "export",
 	Short: "Export events",
-	Long:  "Exports events to JSON, CSV, or ICS format.",
+	Long:  "Exports events to JSON, CSV, ICS, Org-mode, or Markdown format.",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		now := time.Now()
+		var mode export.SplitMode
+		if exportSplit != "" {
+			m, err := export.ParseSplitMode(exportSplit)
+			if err != nil {
+				return err
+			}
+			if exportOutputDir == "" {
+				return fmt.Errorf("--split requires --output-dir")
+			}
+			if exportOutputFile != "" {
+				return fmt.Errorf("--split and --output-file cannot be used together")
+			}
+			mode = m
+		} else if exportOutputDir != "" {
+			return fmt.Errorf("--output-dir requires --split")
+		}
+
+		now := displayNow()
 		from := now.AddDate(0, 0, -30)
 		if exportFrom != "" {
-			t, err := dateparser.ParseDate(exportFrom)
+			t, err := parseDate(exportFrom)
 			if err != nil {
 				return fmt.Errorf("invalid --from date: %w", err)
 			}
@@ -36,7 +57,7 @@ var exportCmd = &cobra.Command{
 
 		to := now.AddDate(0, 0, 30)
 		if exportTo != "" {
-			t, err := dateparser.ParseDate(exportTo)
+			t, err := parseDate(exportTo)
 			if err != nil {
 				return fmt.Errorf("invalid --to date: %w", err)
 			}
@@ -48,19 +69,23 @@ var exportCmd = &cobra.Command{
 			return handleClientError(err)
 		}
 
-		var opts []calendar.ListOption
-		normalized := normalizeCalendarNames(exportCalendars)
-		if len(normalized) == 1 {
-			opts = append(opts, calendar.WithCalendar(normalized[0]))
-		} else if len(normalized) > 1 {
-			opts = append(opts, calendar.WithCalendars(normalized))
-		}
-
-		events, err := client.Events(from, to, opts...)
+		events, err := exportFilter.fetch(client, from, to)
 		if err != nil {
 			return fmt.Errorf("failed to fetch events: %w", err)
 		}
 
+		var calendars []calendar.Calendar
+		if exportFormatFlag == "ics" {
+			calendars, err = client.Calendars()
+			if err != nil {
+				return fmt.Errorf("failed to fetch calendars: %w", err)
+			}
+		}
+
+		if mode != "" {
+			return writeSplitExport(events, calendars, mode, from, to)
+		}
+
 		w := os.Stdout
 		if exportOutputFile != "" {
 			f, err := os.Create(exportOutputFile)
@@ -71,23 +96,62 @@ var exportCmd = &cobra.Command{
 			w = f
 		}
 
-		switch exportFormatFlag {
-		case "csv":
-			return export.CSV(events, w)
-		case "ics":
-			return export.ICS(events, w)
-		default:
-			return export.JSON(events, w)
-		}
+		return export.Write(exportFormatFlag, events, calendars, w, export.WithDisplayZone(ui.DisplayLocation()))
 	},
 }
 
+// writeSplitExport writes one file per group into --output-dir, followed by
+// the index manifest. Existing files with the same names are overwritten so
+// the directory can be refreshed in place.
+func writeSplitExport(events []calendar.Event, calendars []calendar.Calendar, mode export.SplitMode, from, to time.Time) error {
+	if err := os.MkdirAll(exportOutputDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	groups := export.Split(events, mode, export.Extension(exportFormatFlag), export.WithDisplayZone(ui.DisplayLocation()))
+	for _, g := range groups {
+		if err := writeExportFile(filepath.Join(exportOutputDir, g.File), func(f *os.File) error {
+			return export.Write(exportFormatFlag, g.Events, calendars, f, export.WithDisplayZone(ui.DisplayLocation()))
+		}); err != nil {
+			return err
+		}
+	}
+
+	manifest := export.NewManifest(groups, mode, exportFormatFlag, from, to)
+	if err := writeExportFile(filepath.Join(exportOutputDir, export.ManifestFile), func(f *os.File) error {
+		return export.WriteManifest(manifest, f)
+	}); err != nil {
+		return err
+	}
+
+	green := color.New(color.FgGreen, color.Bold)
+	green.Printf("Exported %d events to %d files in %s\n", len(events), len(groups), exportOutputDir)
+	return nil
+}
+
+func writeExportFile(path string, write func(f *os.File) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
 func init() {
 	exportCmd.Flags().StringVarP(&exportFrom, "from", "f", "", "Start date (default: 30 days ago)")
 	exportCmd.Flags().StringVarP(&exportTo, "to", "t", "", "End date (default: 30 days ahead)")
-	exportCmd.Flags().StringArrayVarP(&exportCalendars, "calendar", "c", nil, "Filter by calendar name (repeatable)")
-	exportCmd.Flags().StringVar(&exportFormatFlag, "format", "json", "Format: json, csv, ics")
+	exportFilter.addFlags(exportCmd, true)
+	exportCmd.Flags().StringVar(&exportFormatFlag, "format", "json", "Format: json, csv, ics, org, markdown")
 	exportCmd.Flags().StringVar(&exportOutputFile, "output-file", "", "Write to file instead of stdout")
+	exportCmd.Flags().StringVar(&exportSplit, "split", "", "Write one file per: calendar, month, event")
+	exportCmd.Flags().StringVar(&exportOutputDir, "output-dir", "", "Directory for --split output")
 
 	rootCmd.AddCommand(exportCmd)
 }
@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"strings"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/spf13/cobra"
+)
+
+// eventFilter holds the event-selection flags shared by list, today,
+// upcoming, search, export and the other commands that select events. Calendar, calendar ID and search are pushed
+// down to EventKit as list options; the rest are applied to the fetched
+// events, since EventKit has no predicate for them.
+type eventFilter struct {
+	calendars        []string
+	calendarID       string
+	search           string
+	excludeCalendars []string
+	attendee         string
+	allDay           bool
+	noRecurring      bool
+}
+
+// addFlags registers the filter flags on cmd. Commands that take the search
+// query as an argument (search) pass withSearch=false.
+func (f *eventFilter) addFlags(cmd *cobra.Command, withSearch bool) {
+	flags := cmd.Flags()
+	flags.StringArrayVarP(&f.calendars, "calendar", "c", nil, "Filter by calendar name (repeatable)")
+	flags.StringVar(&f.calendarID, "calendar-id", "", "Filter by calendar ID")
+	if withSearch {
+		flags.StringVarP(&f.search, "search", "s", "", "Search title, location, notes")
+	}
+	flags.BoolVar(&f.allDay, "all-day", false, "Show only all-day events")
+	flags.StringArrayVar(&f.excludeCalendars, "exclude-calendar", nil, "Exclude calendars by name (repeatable)")
+	flags.StringVarP(&f.attendee, "attendee", "a", "", "Filter by attendee or organizer name/email")
+	flags.BoolVar(&f.noRecurring, "no-recurring", false, "Hide recurring events")
+}
+
+func (f *eventFilter) listOptions() []calendar.ListOption {
+	var opts []calendar.ListOption
+	normalized := normalizeCalendarNames(f.calendars)
+	if len(normalized) == 1 {
+		opts = append(opts, calendar.WithCalendar(normalized[0]))
+	} else if len(normalized) > 1 {
+		opts = append(opts, calendar.WithCalendars(normalized))
+	}
+	if f.calendarID != "" {
+		opts = append(opts, calendar.WithCalendarID(f.calendarID))
+	}
+	if f.search != "" {
+		opts = append(opts, calendar.WithSearch(f.search))
+	}
+	return opts
+}
+
+// apply runs the client-side filters over events fetched with listOptions.
+func (f *eventFilter) apply(events []calendar.Event) []calendar.Event {
+	if f.allDay {
+		filtered := make([]calendar.Event, 0, len(events))
+		for _, e := range events {
+			if e.AllDay {
+				filtered = append(filtered, e)
+			}
+		}
+		events = filtered
+	}
+
+	events = filterExcludedCalendars(events, f.excludeCalendars)
+
+	if f.noRecurring {
+		events = filterRecurring(events)
+	}
+
+	if f.attendee != "" {
+		filtered := make([]calendar.Event, 0, len(events))
+		for _, e := range events {
+			if attendeeMatches(e, f.attendee) {
+				filtered = append(filtered, e)
+			}
+		}
+		events = filtered
+	}
+
+	return events
+}
+
+// applyAll runs every filter over events that did not come from EventKit,
+// such as diff's export files, doing the calendar, calendar ID and search
+// matching listOptions would have pushed down.
+func (f *eventFilter) applyAll(events []calendar.Event) []calendar.Event {
+	events = filterIncludedCalendars(events, f.calendars)
+	if f.calendarID != "" || f.search != "" {
+		query := strings.ToLower(f.search)
+		filtered := make([]calendar.Event, 0, len(events))
+		for _, e := range events {
+			if f.calendarID != "" && e.CalendarID != f.calendarID {
+				continue
+			}
+			if query != "" && !strings.Contains(strings.ToLower(e.Title), query) &&
+				!strings.Contains(strings.ToLower(e.Location), query) &&
+				!strings.Contains(strings.ToLower(e.Notes), query) {
+				continue
+			}
+			filtered = append(filtered, e)
+		}
+		events = filtered
+	}
+	return f.apply(events)
+}
+
+// fetch lists events in [from, to) and applies the filter.
+func (f *eventFilter) fetch(client *calendar.Client, from, to time.Time) ([]calendar.Event, error) {
+	events, err := client.Events(from, to, f.listOptions()...)
+	if err != nil {
+		return nil, err
+	}
+	return f.apply(events), nil
+}
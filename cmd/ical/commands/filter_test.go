@@ -0,0 +1,134 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/spf13/cobra"
+)
+
+func TestEventFilterApply(t *testing.T) {
+	events := []calendar.Event{
+		{Title: "1:1 Alice", Calendar: "Work", Attendees: []calendar.Attendee{{Name: "Alice Smith", Email: "alice@example.com"}}},
+		{Title: "Weekly 1:1 Alice", Calendar: "Work", Recurring: true, Attendees: []calendar.Attendee{{Email: "alice@example.com"}}},
+		{Title: "Offsite", Calendar: "Work", AllDay: true},
+		{Title: "Diwali", Calendar: "Holidays", AllDay: true},
+		{Title: "Lunch with Bob", Calendar: "Personal", Organizer: "Bob"},
+	}
+
+	tests := []struct {
+		name   string
+		filter eventFilter
+		want   []string
+	}{
+		{"no filters", eventFilter{}, []string{"1:1 Alice", "Weekly 1:1 Alice", "Offsite", "Diwali", "Lunch with Bob"}},
+		{"all-day", eventFilter{allDay: true}, []string{"Offsite", "Diwali"}},
+		{"exclude calendar", eventFilter{excludeCalendars: []string{" holidays "}}, []string{"1:1 Alice", "Weekly 1:1 Alice", "Offsite", "Lunch with Bob"}},
+		{"no recurring", eventFilter{noRecurring: true}, []string{"1:1 Alice", "Offsite", "Diwali", "Lunch with Bob"}},
+		{"attendee", eventFilter{attendee: "alice"}, []string{"1:1 Alice", "Weekly 1:1 Alice"}},
+		{"organizer matches attendee", eventFilter{attendee: "bob"}, []string{"Lunch with Bob"}},
+		{
+			"combined",
+			eventFilter{attendee: "alice", noRecurring: true, excludeCalendars: []string{"Holidays"}},
+			[]string{"1:1 Alice"},
+		},
+		{"all-day minus holidays", eventFilter{allDay: true, excludeCalendars: []string{"Holidays"}}, []string{"Offsite"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.filter.apply(events)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d events, want %d: %v", len(got), len(tt.want), titles(got))
+			}
+			for i, e := range got {
+				if e.Title != tt.want[i] {
+					t.Errorf("event %d: got %q, want %q", i, e.Title, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestEventFilterApplyAll(t *testing.T) {
+	events := []calendar.Event{
+		{Title: "Standup", Calendar: "Work", CalendarID: "w1", Recurring: true},
+		{Title: "Review", Calendar: "Work", CalendarID: "w1", Notes: "Quarterly STANDUP numbers"},
+		{Title: "Dentist", Calendar: "Home", CalendarID: "h1", Location: "Main St"},
+	}
+
+	tests := []struct {
+		name   string
+		filter eventFilter
+		want   []string
+	}{
+		{"no filters", eventFilter{}, []string{"Standup", "Review", "Dentist"}},
+		{"calendar", eventFilter{calendars: []string{"home"}}, []string{"Dentist"}},
+		{"calendar id", eventFilter{calendarID: "w1"}, []string{"Standup", "Review"}},
+		{"search title and notes", eventFilter{search: "standup"}, []string{"Standup", "Review"}},
+		{"search location", eventFilter{search: "main st"}, []string{"Dentist"}},
+		{"search and client-side", eventFilter{search: "standup", noRecurring: true}, []string{"Review"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := titles(tt.filter.applyAll(events))
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventFilterListOptions(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter eventFilter
+		want   int
+	}{
+		{"none", eventFilter{}, 0},
+		{"single calendar", eventFilter{calendars: []string{"Work"}}, 1},
+		{"multiple calendars", eventFilter{calendars: []string{"Work", "Home"}}, 1},
+		{"blank calendars ignored", eventFilter{calendars: []string{" ", ""}}, 0},
+		{"calendar, id and search", eventFilter{calendars: []string{"Work"}, calendarID: "abc", search: "standup"}, 3},
+		{"client-side only", eventFilter{attendee: "alice", allDay: true, noRecurring: true}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := len(tt.filter.listOptions()); got != tt.want {
+				t.Errorf("got %d options, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventFilterAddFlags(t *testing.T) {
+	var f eventFilter
+	cmd := &cobra.Command{Use: "test"}
+	f.addFlags(cmd, false)
+	if cmd.Flags().Lookup("search") != nil {
+		t.Error("--search should not be registered when withSearch is false")
+	}
+	for _, name := range []string{"calendar", "calendar-id", "all-day", "exclude-calendar", "attendee", "no-recurring"} {
+		if cmd.Flags().Lookup(name) == nil {
+			t.Errorf("missing --%s", name)
+		}
+	}
+
+	if err := cmd.ParseFlags([]string{"-a", "alice", "--exclude-calendar", "Holidays", "--no-recurring"}); err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if f.attendee != "alice" || len(f.excludeCalendars) != 1 || !f.noRecurring {
+		t.Errorf("flags not bound: %+v", f)
+	}
+}
+
+func titles(events []calendar.Event) []string {
+	out := make([]string, len(events))
+	for i, e := range events {
+		out[i] = e.Title
+	}
+	return out
+}
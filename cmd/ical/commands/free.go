@@ -6,7 +6,7 @@ import (
 	"time"
 
 	"github.com/BRO3886/go-eventkit/calendar"
-	"github.com/BRO3886/go-eventkit/dateparser"
+	"github.com/BRO3886/ical/internal/ui"
 	"github.com/spf13/cobra"
 )
 
@@ -33,9 +33,9 @@ Defaults to the next 24 hours; use --from/--to to change the window.`,
 			}
 		}
 
-		start := time.Now()
+		start := displayNow()
 		if freeFrom != "" {
-			t, err := dateparser.ParseDate(freeFrom)
+			t, err := parseDate(freeFrom)
 			if err != nil {
 				return fmt.Errorf("invalid --from: %w", err)
 			}
@@ -43,7 +43,7 @@ Defaults to the next 24 hours; use --from/--to to change the window.`,
 		}
 		end := start.Add(24 * time.Hour)
 		if freeTo != "" {
-			t, err := dateparser.ParseDate(freeTo)
+			t, err := parseDate(freeTo)
 			if err != nil {
 				return fmt.Errorf("invalid --to: %w", err)
 			}
@@ -85,10 +85,11 @@ func printAvailability(result map[string][]calendar.AvailabilitySpan, addresses
 			continue
 		}
 		fmt.Printf("%s:\n", addr)
+		loc := ui.CurrentLocale()
 		for _, s := range busy {
 			fmt.Printf("  %s – %s  %s\n",
-				s.Start.In(time.Local).Format("Mon 02 Jan 15:04"),
-				s.End.In(time.Local).Format("15:04"),
+				loc.DateTime(s.Start.In(ui.DisplayLocation())),
+				loc.Clock(s.End.In(ui.DisplayLocation())),
 				s.Type)
 		}
 	}
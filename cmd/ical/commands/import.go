@@ -6,24 +6,41 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
-	"github.com/BRO3886/ical/internal/export"
 	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/ical/internal/export"
+	"github.com/BRO3886/ical/internal/ui"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
 var (
-	importCalendar string
-	importDryRun   bool
-	importForce    bool
+	importCalendar        string
+	importDryRun          bool
+	importForce           bool
+	importCreateCalendars bool
+	importSource          string
+	importTimeZone        string
+	importFloating        string
 )
 
 var importCmd = &cobra.Command{
 	Use:   "import [file]",
 	Short: "Import events from file",
-	Long:  "Imports events from JSON, CSV, or ICS files.",
-	Args:  cobra.ExactArgs(1),
+	Long: `Imports events from JSON, CSV, ICS, or Org-mode files.
+
+Events go to the calendar recorded in the file, or to --calendar. With
+--create-calendars, calendars that do not exist are created first (with the
+color and account recorded by 'ical export --format ics'); otherwise they are
+listed, and their events fail to import. A --calendar that does not exist is
+an error.
+
+Times without a zone (ICS floating times, CSV timestamps without an offset,
+Org timestamps) are read in --timezone, or the local zone. --floating sets
+the zone such events are stored in: local (the zone they were read in),
+utc, or keep (no zone; Calendar applies its default).`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		filename := args[0]
 
@@ -33,18 +50,26 @@ var importCmd = &cobra.Command{
 		}
 		defer f.Close()
 
+		parseOpts, err := importParseOptions()
+		if err != nil {
+			return err
+		}
+
 		ext := strings.ToLower(filepath.Ext(filename))
 		var inputs []calendar.CreateEventInput
+		var fileCalendars []calendar.Calendar
 
 		switch ext {
 		case ".json":
 			inputs, err = export.ParseJSON(f)
 		case ".csv":
-			inputs, err = export.ParseCSV(f)
+			inputs, err = export.ParseCSV(f, parseOpts...)
 		case ".ics":
-			inputs, err = export.ParseICS(f)
+			inputs, fileCalendars, err = export.ParseICSCalendars(f, parseOpts...)
+		case ".org":
+			inputs, err = export.ParseOrg(f, parseOpts...)
 		default:
-			return fmt.Errorf("unsupported file format %q (use .json, .csv, or .ics)", ext)
+			return fmt.Errorf("unsupported file format %q (use .json, .csv, .ics, or .org)", ext)
 		}
 		if err != nil {
 			return fmt.Errorf("failed to parse file: %w", err)
@@ -56,13 +81,35 @@ var importCmd = &cobra.Command{
 			}
 		}
 
+		client, err := calendar.New()
+		if err != nil {
+			return handleClientError(err)
+		}
+		existing, err := client.Calendars()
+		if err != nil {
+			return fmt.Errorf("failed to fetch calendars: %w", err)
+		}
+		plan, err := planImportCalendars(inputs, existing, fileCalendars, importCreateCalendars, importSource)
+		if err != nil {
+			return err
+		}
+		if importCalendar != "" && len(plan.missing) > 0 {
+			return fmt.Errorf("calendar %q not found (pass --create-calendars to create it)", importCalendar)
+		}
+
 		if importDryRun {
+			for _, c := range plan.create {
+				fmt.Printf("Dry run: would create calendar %q in %s\n", c.Title, c.Source)
+			}
+			for _, name := range plan.missing {
+				fmt.Printf("Dry run: calendar %q not found; its events would fail (use --create-calendars)\n", name)
+			}
 			fmt.Printf("Dry run: would create %d events\n", len(inputs))
 			for _, input := range inputs {
 				fmt.Printf("  - %s (%s - %s) [%s]\n",
 					input.Title,
-					input.StartDate.Format("Jan 02 15:04"),
-					input.EndDate.Format("Jan 02 15:04"),
+					ui.CurrentLocale().DateTime(ui.DisplayTime(input.StartDate, input.AllDay)),
+					ui.CurrentLocale().DateTime(ui.DisplayTime(input.EndDate, input.AllDay)),
 					input.Calendar,
 				)
 			}
@@ -80,9 +127,15 @@ var importCmd = &cobra.Command{
 			}
 		}
 
-		client, err := calendar.New()
-		if err != nil {
-			return handleClientError(err)
+		yellow := color.New(color.FgYellow)
+		for _, name := range plan.missing {
+			yellow.Fprintf(os.Stderr, "Warning: calendar %q not found; its events will fail (use --create-calendars to create it)\n", name)
+		}
+		for _, c := range plan.create {
+			if _, err := client.CreateCalendar(c); err != nil {
+				return fmt.Errorf("failed to create calendar %q: %w", c.Title, err)
+			}
+			fmt.Printf("Created calendar %q in %s\n", c.Title, c.Source)
 		}
 
 		created := 0
@@ -90,7 +143,6 @@ var importCmd = &cobra.Command{
 		for _, input := range inputs {
 			_, err := client.CreateEvent(input)
 			if err != nil {
-				yellow := color.New(color.FgYellow)
 				yellow.Fprintf(os.Stderr, "Warning: failed to create %q: %v\n", input.Title, err)
 				errors++
 				continue
@@ -113,6 +165,89 @@ func init() {
 	importCmd.Flags().StringVarP(&importCalendar, "calendar", "c", "", "Override target calendar for all events")
 	importCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "Preview without creating")
 	importCmd.Flags().BoolVarP(&importForce, "force", "f", false, "Skip confirmation prompt")
+	importCmd.Flags().BoolVar(&importCreateCalendars, "create-calendars", false, "Create calendars that do not exist yet")
+	importCmd.Flags().StringVarP(&importSource, "source", "s", "", "Account for created calendars (default: the one recorded in the file)")
+	importCmd.Flags().StringVar(&importTimeZone, "timezone", "", "IANA zone for times without one (default: local)")
+	importCmd.Flags().StringVar(&importFloating, "floating", "local", "Store events with floating times as: local, utc, keep")
 
 	rootCmd.AddCommand(importCmd)
 }
+
+func importParseOptions() ([]export.ParseOption, error) {
+	policy, err := export.ParseFloatingPolicy(importFloating)
+	if err != nil {
+		return nil, err
+	}
+	opts := []export.ParseOption{export.WithFloating(policy)}
+	if importTimeZone != "" {
+		loc, err := time.LoadLocation(importTimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --timezone %q: %w", importTimeZone, err)
+		}
+		opts = append(opts, export.WithTimeZone(loc))
+	}
+	return opts, nil
+}
+
+// importCalendarPlan says what to do about the calendars imported events
+// refer to: which to create, and which are missing, so that their events
+// will fail.
+type importCalendarPlan struct {
+	create  []calendar.CreateCalendarInput
+	missing []string
+}
+
+// planImportCalendars compares the calendars the inputs target with the
+// existing ones. With create set, each missing calendar is created in
+// source if given, else in the account recorded in the file; an account
+// that does not exist here is an error, listing the ones that do.
+func planImportCalendars(inputs []calendar.CreateEventInput, existing, fromFile []calendar.Calendar, create bool, source string) (importCalendarPlan, error) {
+	have := make(map[string]bool, len(existing))
+	sources := make(map[string]string)
+	var sourceNames []string
+	for _, c := range existing {
+		have[normalizeCalendarName(c.Title)] = true
+		key := strings.ToLower(c.Source)
+		if _, ok := sources[key]; !ok && c.Source != "" {
+			sources[key] = c.Source
+			sourceNames = append(sourceNames, c.Source)
+		}
+	}
+	meta := make(map[string]calendar.Calendar, len(fromFile))
+	for _, c := range fromFile {
+		meta[normalizeCalendarName(c.Title)] = c
+	}
+
+	var plan importCalendarPlan
+	planned := make(map[string]bool)
+	for _, in := range inputs {
+		key := normalizeCalendarName(in.Calendar)
+		if key == "" || have[key] || planned[key] {
+			continue
+		}
+		planned[key] = true
+
+		if !create {
+			plan.missing = append(plan.missing, in.Calendar)
+			continue
+		}
+
+		want := source
+		if want == "" {
+			want = meta[key].Source
+		}
+		if want == "" {
+			return plan, fmt.Errorf("calendar %q has no recorded account; pass --source (available: %s)", in.Calendar, strings.Join(sourceNames, ", "))
+		}
+		resolved, ok := sources[strings.ToLower(want)]
+		if !ok {
+			return plan, fmt.Errorf("account %q for calendar %q not found; pass --source (available: %s)", want, in.Calendar, strings.Join(sourceNames, ", "))
+		}
+		plan.create = append(plan.create, calendar.CreateCalendarInput{
+			Title:  in.Calendar,
+			Source: resolved,
+			Color:  meta[key].Color,
+		})
+	}
+	return plan, nil
+}
@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+func TestPlanImportCalendars(t *testing.T) {
+	existing := []calendar.Calendar{
+		{Title: "Work", Source: "iCloud"},
+		{Title: "Birthdays", Source: "Other"},
+	}
+	fromFile := []calendar.Calendar{
+		{Title: "Family", Color: "#FF6961", Source: "iCloud"},
+		{Title: "Gym", Source: "Exchange"},
+	}
+	inputs := []calendar.CreateEventInput{
+		{Title: "Standup", Calendar: "work"},
+		{Title: "Dinner", Calendar: "Family"},
+		{Title: "Picnic", Calendar: "Family"},
+		{Title: "No calendar"},
+	}
+
+	t.Run("missing without create", func(t *testing.T) {
+		plan, err := planImportCalendars(inputs, existing, fromFile, false, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(plan.create) != 0 {
+			t.Errorf("expected nothing to create, got %+v", plan.create)
+		}
+		if len(plan.missing) != 1 || plan.missing[0] != "Family" {
+			t.Errorf("missing: got %v", plan.missing)
+		}
+	})
+
+	t.Run("create with recorded account and color", func(t *testing.T) {
+		plan, err := planImportCalendars(inputs, existing, fromFile, true, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(plan.create) != 1 {
+			t.Fatalf("expected 1 calendar to create, got %+v", plan.create)
+		}
+		want := calendar.CreateCalendarInput{Title: "Family", Source: "iCloud", Color: "#FF6961"}
+		if plan.create[0] != want {
+			t.Errorf("got %+v, want %+v", plan.create[0], want)
+		}
+	})
+
+	t.Run("source flag overrides recorded account", func(t *testing.T) {
+		plan, err := planImportCalendars(inputs, existing, fromFile, true, "other")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if plan.create[0].Source != "Other" {
+			t.Errorf("source: got %q", plan.create[0].Source)
+		}
+	})
+
+	t.Run("unknown account", func(t *testing.T) {
+		gym := []calendar.CreateEventInput{{Title: "Leg day", Calendar: "Gym"}}
+		_, err := planImportCalendars(gym, existing, fromFile, true, "")
+		if err == nil || !strings.Contains(err.Error(), "Exchange") {
+			t.Errorf("expected unknown account error, got %v", err)
+		}
+	})
+
+	t.Run("no recorded account", func(t *testing.T) {
+		other := []calendar.CreateEventInput{{Title: "Trip", Calendar: "Travel"}}
+		_, err := planImportCalendars(other, existing, nil, true, "")
+		if err == nil || !strings.Contains(err.Error(), "--source") {
+			t.Errorf("expected --source hint, got %v", err)
+		}
+	})
+}
@@ -3,9 +3,9 @@ package commands
 import (
 	"encoding/json"
 	"fmt"
-	"time"
 
 	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/ical/internal/ui"
 	"github.com/spf13/cobra"
 )
 
@@ -37,9 +37,10 @@ var inboxCmd = &cobra.Command{
 		}
 
 		for i, inv := range invitations {
-			when := inv.Start.In(time.Local).Format("Mon 02 Jan 15:04")
+			loc := ui.CurrentLocale()
+			when := loc.DateTime(inv.Start.In(ui.DisplayLocation()))
 			if inv.AllDay {
-				when = inv.Start.In(time.Local).Format("Mon 02 Jan") + " (all day)"
+				when = loc.Date(ui.DisplayTime(inv.Start, true), false) + " (all day)"
 			}
 			fmt.Printf("%d. %s\n   %s", i+1, inv.Title, when)
 			if inv.Organizer != "" {
@@ -45,13 +45,13 @@ full/partial event ID, same as 'ical show'.`,
 				return fmt.Errorf("event %q has no conference link", event.Title)
 			}
 		} else {
-			now := time.Now()
+			now := displayNow()
 			// EventKit's range predicate uses overlap semantics, so any
 			// ongoing event is returned as long as the range starts before it
 			// ends. Start at local midnight anyway so all-day events for
 			// "today" are reliably in range across timezones.
 			y, m, d := now.Date()
-			dayStart := time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+			dayStart := time.Date(y, m, d, 0, 0, 0, 0, ui.DisplayLocation())
 			events, err := client.Events(dayStart, now.AddDate(0, 0, joinDays))
 			if err != nil {
 				return fmt.Errorf("failed to fetch events: %w", err)
@@ -67,12 +67,11 @@ full/partial event ID, same as 'ical show'.`,
 			return nil
 		}
 		if outputFormat == "json" {
-			ui.PrintEventDetail(event, "json")
-			return nil
+			return ui.PrintEventDetail(event, "json")
 		}
 
-		start := event.StartDate.In(time.Local)
-		fmt.Fprintf(os.Stderr, "Joining %q (%s)\n", event.Title, start.Format("Mon 15:04"))
+		start := ui.DisplayTime(event.StartDate, event.AllDay)
+		fmt.Fprintf(os.Stderr, "Joining %q (%s)\n", event.Title, ui.CurrentLocale().Format(start, "Mon ")+ui.CurrentLocale().Clock(start))
 		fmt.Println(event.ConferenceURL)
 		if err := exec.Command("open", event.ConferenceURL).Run(); err != nil {
 			return fmt.Errorf("failed to open conference link: %w", err)
@@ -89,13 +88,22 @@ func init() {
 }
 
 // nextJoinableEvent picks the event whose conference link the user most
-// likely wants: an ongoing event (started, not yet ended) with a link —
-// preferring the most recently started one — or else the upcoming linked
-// event that starts soonest. Returns nil if no event has a conference link.
+// likely wants: an ongoing event with a link, or else the next one.
+// Returns nil if no event has a conference link.
 func nextJoinableEvent(events []calendar.Event, now time.Time) *calendar.Event {
+	return currentOrNextEvent(events, now, func(e calendar.Event) bool {
+		return e.ConferenceURL != ""
+	})
+}
+
+// currentOrNextEvent picks, among events accepted by keep, an ongoing
+// event (started, not yet ended) — preferring the most recently started
+// one — or else the upcoming event that starts soonest. Returns nil if
+// none qualify.
+func currentOrNextEvent(events []calendar.Event, now time.Time, keep func(calendar.Event) bool) *calendar.Event {
 	var ongoing, upcoming []calendar.Event
 	for _, e := range events {
-		if e.ConferenceURL == "" {
+		if !keep(e) {
 			continue
 		}
 		switch {
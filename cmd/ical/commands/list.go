@@ -9,21 +9,16 @@ import (
 	"github.com/BRO3886/go-eventkit/calendar"
 	"github.com/BRO3886/go-eventkit/dateparser"
 	"github.com/BRO3886/ical/internal/ui"
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
 var (
-	listFrom            string
-	listTo              string
-	listCalendars       []string
-	listCalendarID      string
-	listSearch          string
-	listAllDay          bool
-	listSort            string
-	listLimit           int
-	listExcludeCalendar []string
-	listAttendee        string
-	listNoRecurring     bool
+	listFrom   string
+	listTo     string
+	listFilter eventFilter
+	listSort   string
+	listLimit  int
 )
 
 var listCmd = &cobra.Command{
@@ -32,11 +27,11 @@ var listCmd = &cobra.Command{
 	Short:   "List events in a date range",
 	Long:    "List events within a date range. Defaults to today if no range specified.",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		now := time.Now()
+		now := displayNow()
 
 		from := startOfDay(now)
 		if listFrom != "" {
-			t, err := dateparser.ParseDate(listFrom)
+			t, err := parseDate(listFrom)
 			if err != nil {
 				return fmt.Errorf("invalid --from date: %w", err)
 			}
@@ -45,7 +40,7 @@ var listCmd = &cobra.Command{
 
 		to := from.Add(24 * time.Hour)
 		if listTo != "" {
-			t, err := dateparser.ParseDate(listTo)
+			t, err := parseDate(listTo)
 			if err != nil {
 				return fmt.Errorf("invalid --to date: %w", err)
 			}
@@ -59,15 +54,9 @@ var listCmd = &cobra.Command{
 func init() {
 	listCmd.Flags().StringVarP(&listFrom, "from", "f", "", "Start date (natural language or ISO 8601)")
 	listCmd.Flags().StringVarP(&listTo, "to", "t", "", "End date (natural language or ISO 8601)")
-	listCmd.Flags().StringArrayVarP(&listCalendars, "calendar", "c", nil, "Filter by calendar name (repeatable)")
-	listCmd.Flags().StringVar(&listCalendarID, "calendar-id", "", "Filter by calendar ID")
-	listCmd.Flags().StringVarP(&listSearch, "search", "s", "", "Search title, location, notes")
-	listCmd.Flags().BoolVar(&listAllDay, "all-day", false, "Show only all-day events")
+	listFilter.addFlags(listCmd, true)
 	listCmd.Flags().StringVar(&listSort, "sort", "start", "Sort by: start, end, title, calendar")
 	listCmd.Flags().IntVarP(&listLimit, "limit", "n", 0, "Max events to display")
-	listCmd.Flags().StringArrayVar(&listExcludeCalendar, "exclude-calendar", nil, "Exclude calendars by name (repeatable)")
-	listCmd.Flags().StringVarP(&listAttendee, "attendee", "a", "", "Filter by attendee or organizer name/email")
-	listCmd.Flags().BoolVar(&listNoRecurring, "no-recurring", false, "Hide recurring events")
 
 	rootCmd.AddCommand(listCmd)
 }
@@ -78,63 +67,47 @@ func listEvents(from, to time.Time) error {
 		return handleClientError(err)
 	}
 
-	opts := buildListOptions()
-	events, err := client.Events(from, to, opts...)
+	events, err := listFilter.fetch(client, from, to)
 	if err != nil {
 		return fmt.Errorf("failed to list events: %w", err)
 	}
 
-	if listAllDay {
-		filtered := make([]calendar.Event, 0)
-		for _, e := range events {
-			if e.AllDay {
-				filtered = append(filtered, e)
-			}
-		}
-		events = filtered
-	}
-
-	events = filterExcludedCalendars(events, listExcludeCalendar)
-
-	if listNoRecurring {
-		events = filterRecurring(events)
-	}
-
-	if listAttendee != "" {
-		filtered := make([]calendar.Event, 0, len(events))
-		for _, e := range events {
-			if attendeeMatches(e, listAttendee) {
-				filtered = append(filtered, e)
-			}
-		}
-		events = filtered
-	}
-
 	sortEvents(events, listSort)
 
 	if listLimit > 0 && len(events) > listLimit {
 		events = events[:listLimit]
 	}
 
-	ui.PrintEvents(events, outputFormat)
-	return nil
+	loadCalendarColors(client, outputFormat)
+	return ui.PrintEvents(events, outputFormat)
 }
 
-func buildListOptions() []calendar.ListOption {
-	var opts []calendar.ListOption
-	normalized := normalizeCalendarNames(listCalendars)
-	if len(normalized) == 1 {
-		opts = append(opts, calendar.WithCalendar(normalized[0]))
-	} else if len(normalized) > 1 {
-		opts = append(opts, calendar.WithCalendars(normalized))
+// loadCalendarColors lets table, agenda and detail output mark events with
+// their calendar's color. It is skipped for machine formats and under
+// --no-color, where the extra fetch would go unused, and a failed fetch
+// just leaves output uncolored.
+func loadCalendarColors(client *calendar.Client, format string) {
+	if color.NoColor || (format != "table" && format != "agenda") {
+		return
 	}
-	if listCalendarID != "" {
-		opts = append(opts, calendar.WithCalendarID(listCalendarID))
+	calendars, err := client.Calendars()
+	if err != nil {
+		return
+	}
+	ui.SetCalendarColors(ui.NewCalendarColors(calendars))
+}
+
+// agendaByDefault makes -o agenda the default when stdout is a terminal.
+// An explicit -o, --template-file or --fields keeps the requested output.
+func agendaByDefault(cmd *cobra.Command) {
+	for _, name := range []string{"output", "template-file", "fields"} {
+		if cmd.Flags().Changed(name) {
+			return
+		}
 	}
-	if listSearch != "" {
-		opts = append(opts, calendar.WithSearch(listSearch))
+	if ui.IsTerminal() {
+		outputFormat = "agenda"
 	}
-	return opts
 }
 
 func sortEvents(events []calendar.Event, sortBy string) {
@@ -252,6 +225,25 @@ func filterIncludedCalendars(events []calendar.Event, include []string) []calend
 	return filtered
 }
 
+// displayNow is the current time in the display zone (--tz), so default
+// ranges such as "today" follow it.
+func displayNow() time.Time {
+	return time.Now().In(ui.DisplayLocation())
+}
+
+// parseDate parses a natural-language or ISO date relative to displayNow,
+// so "tomorrow 9am" means 9am in the display zone.
+func parseDate(s string, opts ...dateparser.Option) (time.Time, error) {
+	return dateparser.ParseDateRelativeTo(s, displayNow(), opts...)
+}
+
+// floatingTime keeps t's wall-clock time but moves it to the system zone,
+// where EventKit reads all-day dates, so a day parsed in the display zone
+// stays that day.
+func floatingTime(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.Local)
+}
+
 func startOfDay(t time.Time) time.Time {
 	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
 }
@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/ical/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	monthFrom     string
+	monthCount    int
+	monthTitles   int
+	monthStartDay string
+	monthFilter   eventFilter
+)
+
+var monthCmd = &cobra.Command{
+	Use:     "month",
+	Aliases: []string{"cal"},
+	Short:   "Show a cal-style month grid with event markers",
+	Long: `Show a month as a cal(1)-style grid. Each day shows how many events it
+has, with * marking days that have all-day events; today is highlighted.
+
+--titles N shows up to N event titles per day instead of a count, each
+prefixed with its row number, so 'ical show 4' works afterwards.
+--months prints several months side by side, wrapping to fit the terminal.
+With -o json, plain, csv or a template, the events are printed as a list.`,
+	Example: `  ical month
+  ical month --months 3
+  ical month --from "next month" --titles 3
+  ical month --start-day sunday --exclude-calendar Birthdays`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if monthCount < 1 {
+			return fmt.Errorf("--months must be at least 1")
+		}
+		if monthTitles < 0 {
+			return fmt.Errorf("--titles cannot be negative")
+		}
+		weekday, err := parseWeekday(monthStartDay)
+		if err != nil {
+			return err
+		}
+
+		now := displayNow()
+		day := now
+		if monthFrom != "" {
+			day, err = parseDate(monthFrom)
+			if err != nil {
+				return fmt.Errorf("invalid --from date: %w", err)
+			}
+		}
+		from := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, ui.DisplayLocation())
+		to := from.AddDate(0, monthCount, 0)
+
+		client, err := calendar.New()
+		if err != nil {
+			return handleClientError(err)
+		}
+
+		events, err := monthFilter.fetch(client, from, to)
+		if err != nil {
+			return fmt.Errorf("failed to list events: %w", err)
+		}
+		sortEvents(events, "start")
+
+		if outputFormat != "table" {
+			loadCalendarColors(client, outputFormat)
+			return ui.PrintEvents(events, outputFormat)
+		}
+
+		calendars, err := client.Calendars()
+		if err != nil {
+			return fmt.Errorf("failed to fetch calendars: %w", err)
+		}
+
+		// Count mode shows no row numbers, so only --titles leaves a list
+		// for "ical show N" to point into.
+		if monthTitles > 0 {
+			ui.SaveLastList(events)
+		}
+		ui.RenderMonths(os.Stdout, ui.MonthView{
+			Start:     from,
+			Months:    monthCount,
+			WeekStart: weekday,
+			Events:    events,
+			Titles:    monthTitles,
+			Colors:    ui.NewCalendarColors(calendars),
+			Now:       now,
+			Width:     ui.TerminalWidth(),
+		})
+		return nil
+	},
+}
+
+func init() {
+	monthCmd.Flags().StringVarP(&monthFrom, "from", "f", "", "Any day in the first month to show (natural language or ISO 8601)")
+	monthCmd.Flags().IntVarP(&monthCount, "months", "m", 1, "Number of months to show")
+	monthCmd.Flags().IntVar(&monthTitles, "titles", 0, "Show up to N titles per day instead of a count")
+	monthCmd.Flags().StringVar(&monthStartDay, "start-day", "", "First day of the week (default: locale week_start, monday)")
+	monthFilter.addFlags(monthCmd, true)
+
+	rootCmd.AddCommand(monthCmd)
+}
@@ -0,0 +1,309 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/ical/internal/ui"
+	runewidth "github.com/mattn/go-runewidth"
+	"github.com/spf13/cobra"
+)
+
+var (
+	nowFormat   string
+	nowWaybar   bool
+	nowDays     int
+	nowCacheTTL time.Duration
+	nowMaxTitle int
+	nowFilter   eventFilter
+)
+
+// nowSoon is how close an upcoming event has to be for the "soon" class.
+const nowSoon = 5 * time.Minute
+
+// nowHorizon is how far ahead "in 3h" is used before switching to a date.
+const nowHorizon = 12 * time.Hour
+
+var nowCmd = &cobra.Command{
+	Use:   "now",
+	Short: "Print the current or next event, for status bars",
+	Long: `Prints the event happening now, or else the next one, in one short line:
+
+  Standup in 12m
+  1:1 w/ Ana · 25m left
+
+Prints nothing when there is no event in the next --days days. All-day,
+declined and cancelled events are skipped.
+
+Built to be polled from tmux, polybar, waybar or sketchybar: events are
+cached in ~/.cache/ical for --cache-ttl, so most runs don't touch EventKit.
+
+--format takes a Go template over .Title, .Calendar, .Location,
+.ConferenceURL, .Start, .End, .Ongoing, .In (time until start), .Left (time
+until end) and .Text (the default line). --waybar prints waybar's custom
+module JSON: text, tooltip and a class of ongoing, soon, upcoming or none.`,
+	Example: `  ical now
+  ical now --format '{{if .Ongoing}}● {{end}}{{.Title}}'
+  ical now --waybar --max-title 30
+  ical now -c Work --cache-ttl 0`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var tmpl *template.Template
+		if nowFormat != "" {
+			var err error
+			tmpl, err = template.New("now").Parse(nowFormat)
+			if err != nil {
+				return fmt.Errorf("invalid --format: %w", err)
+			}
+		}
+
+		now := displayNow()
+		events, err := nowEvents(now)
+		if err != nil {
+			return err
+		}
+		events = nowFilter.apply(events)
+		event := currentOrNextEvent(events, now, nowRelevant)
+
+		if outputFormat == "json" && !nowWaybar {
+			if event == nil {
+				fmt.Println("null")
+				return nil
+			}
+			return ui.PrintEventDetail(event, "json")
+		}
+
+		status := newNowStatus(event, now, nowMaxTitle)
+		switch {
+		case nowWaybar:
+			data, _ := json.Marshal(status.waybar())
+			fmt.Println(string(data))
+		case event == nil:
+			// Nothing to show; status bars hide empty output.
+		case tmpl != nil:
+			var b strings.Builder
+			if err := tmpl.Execute(&b, status); err != nil {
+				return fmt.Errorf("failed to render --format: %w", err)
+			}
+			fmt.Println(strings.TrimRight(b.String(), "\n"))
+		default:
+			fmt.Println(status.Text)
+		}
+		return nil
+	},
+}
+
+func init() {
+	nowCmd.Flags().StringVar(&nowFormat, "format", "", "Go template for the line (see --help for fields)")
+	nowCmd.Flags().BoolVar(&nowWaybar, "waybar", false, "Print waybar JSON (text, tooltip, class)")
+	nowCmd.Flags().IntVarP(&nowDays, "days", "d", 1, "How many days ahead to look for the next event")
+	nowCmd.Flags().DurationVar(&nowCacheTTL, "cache-ttl", time.Minute, "Reuse fetched events for this long (0 disables the cache)")
+	nowCmd.Flags().IntVar(&nowMaxTitle, "max-title", 0, "Truncate titles to this many columns (0 = no limit)")
+	nowFilter.addFlags(nowCmd, true)
+	// now never shows all-day events, so --all-day would only hide the rest.
+	_ = nowCmd.Flags().MarkHidden("all-day")
+
+	rootCmd.AddCommand(nowCmd)
+}
+
+// nowRelevant skips events that don't occupy the user's time.
+func nowRelevant(e calendar.Event) bool {
+	return !e.AllDay &&
+		e.SelfStatus != calendar.ParticipantStatusDeclined &&
+		e.Status != calendar.StatusCanceled
+}
+
+// nowStatus is the data behind every output mode of ical now.
+type nowStatus struct {
+	Title         string
+	Calendar      string
+	Location      string
+	ConferenceURL string
+	Start         time.Time
+	End           time.Time
+	Ongoing       bool
+	In            string
+	Left          string
+	Text          string
+
+	found bool
+	soon  bool
+}
+
+func newNowStatus(e *calendar.Event, now time.Time, maxTitle int) nowStatus {
+	if e == nil {
+		return nowStatus{}
+	}
+	title := e.Title
+	if maxTitle > 0 {
+		title = runewidth.Truncate(title, maxTitle, "…")
+	}
+	s := nowStatus{
+		Title:         title,
+		Calendar:      e.Calendar,
+		Location:      e.Location,
+		ConferenceURL: e.ConferenceURL,
+		Start:         ui.DisplayTime(e.StartDate, e.AllDay),
+		End:           ui.DisplayTime(e.EndDate, e.AllDay),
+		Ongoing:       !e.StartDate.After(now),
+		In:            ui.CompactDuration(ceilMinute(e.StartDate.Sub(now))),
+		Left:          ui.CompactDuration(ceilMinute(e.EndDate.Sub(now))),
+		found:         true,
+	}
+	s.soon = !s.Ongoing && e.StartDate.Sub(now) <= nowSoon
+
+	loc := ui.CurrentLocale()
+	switch {
+	case s.Ongoing:
+		s.Text = fmt.Sprintf("%s · %s left", title, s.Left)
+	case e.StartDate.Sub(now) <= nowHorizon:
+		s.Text = fmt.Sprintf("%s in %s", title, s.In)
+	default:
+		s.Text = fmt.Sprintf("%s %s", title, loc.Format(s.Start, "Mon ")+loc.Clock(s.Start))
+	}
+	return s
+}
+
+// waybarOutput is the JSON a waybar custom module with return-type json
+// expects.
+type waybarOutput struct {
+	Text    string `json:"text"`
+	Tooltip string `json:"tooltip"`
+	Class   string `json:"class"`
+}
+
+func (s nowStatus) waybar() waybarOutput {
+	if !s.found {
+		return waybarOutput{Class: "none"}
+	}
+	loc := ui.CurrentLocale()
+	tooltip := []string{s.Title, loc.TimeRange(s.Start, s.End, false) + " · " + s.Calendar}
+	if s.Location != "" {
+		tooltip = append(tooltip, s.Location)
+	}
+	if s.ConferenceURL != "" {
+		tooltip = append(tooltip, s.ConferenceURL)
+	}
+	class := "upcoming"
+	switch {
+	case s.Ongoing:
+		class = "ongoing"
+	case s.soon:
+		class = "soon"
+	}
+	return waybarOutput{Text: s.Text, Tooltip: strings.Join(tooltip, "\n"), Class: class}
+}
+
+// ceilMinute rounds a positive duration up to the next whole minute, so a
+// meeting 11m30s away reads "in 12m" rather than "in 11m".
+func ceilMinute(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return (d + time.Minute - 1).Truncate(time.Minute)
+}
+
+// nowCache is the on-disk copy of the last fetch.
+type nowCache struct {
+	// Query is the part of the filter pushed down to EventKit; events
+	// fetched for one query do not answer another.
+	Query     string           `json:"query,omitempty"`
+	FetchedAt time.Time        `json:"fetched_at"`
+	From      time.Time        `json:"from"`
+	To        time.Time        `json:"to"`
+	Events    []calendar.Event `json:"events"`
+}
+
+func nowCachePath() string {
+	return filepath.Join(ui.CacheDir(), "now-events.json")
+}
+
+// nowEvents returns events from local midnight through the end of the
+// day --days ahead, from the cache when it is fresh and covers the window.
+// Whole days keep the window the same between polls.
+func nowEvents(now time.Time) ([]calendar.Event, error) {
+	y, m, d := now.Date()
+	from := time.Date(y, m, d, 0, 0, 0, 0, ui.DisplayLocation())
+	to := from.AddDate(0, 0, nowDays+1)
+
+	query := nowCacheQuery(&nowFilter)
+	if nowCacheTTL > 0 {
+		if c := readNowCache(nowCachePath()); c.covers(query, from, to, now, nowCacheTTL) {
+			return c.Events, nil
+		}
+	}
+
+	client, err := calendar.New()
+	if err != nil {
+		return nil, handleClientError(err)
+	}
+	events, err := client.Events(from, to, nowFilter.listOptions()...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch events: %w", err)
+	}
+	if nowCacheTTL > 0 {
+		writeNowCache(nowCachePath(), nowCache{Query: query, FetchedAt: now, From: from, To: to, Events: events})
+	}
+	return events, nil
+}
+
+// nowCacheQuery identifies the filters that change what EventKit returns:
+// calendars, calendar ID and search. The others are applied to the cached
+// events on every run.
+func nowCacheQuery(f *eventFilter) string {
+	calendars := normalizeCalendarNames(f.calendars)
+	if len(calendars) == 0 && f.calendarID == "" && f.search == "" {
+		return ""
+	}
+	return strings.Join([]string{strings.Join(calendars, ","), f.calendarID, f.search}, "\x00")
+}
+
+// covers reports whether the cache was fetched for query, is younger than
+// ttl and spans [from, to).
+func (c *nowCache) covers(query string, from, to, now time.Time, ttl time.Duration) bool {
+	if c == nil || c.Query != query || now.Sub(c.FetchedAt) >= ttl || now.Before(c.FetchedAt) {
+		return false
+	}
+	return !c.From.After(from) && !c.To.Before(to)
+}
+
+func readNowCache(path string) *nowCache {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var c nowCache
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil
+	}
+	return &c
+}
+
+// writeNowCache saves the cache, ignoring errors: a missing cache only
+// costs a fetch.
+func writeNowCache(path string, c nowCache) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return
+	}
+	// A temp file per writer, so pollers firing together never rename a
+	// half-written file into place.
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".now-*")
+	if err != nil {
+		return
+	}
+	_, werr := tmp.Write(data)
+	cerr := tmp.Close()
+	if werr != nil || cerr != nil || os.Rename(tmp.Name(), path) != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
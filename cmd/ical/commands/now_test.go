@@ -0,0 +1,127 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+func TestNowStatus(t *testing.T) {
+	now := time.Date(2026, 3, 17, 10, 0, 0, 0, time.Local)
+	at := func(d time.Duration) time.Time { return now.Add(d) }
+
+	tests := []struct {
+		name      string
+		events    []calendar.Event
+		maxTitle  int
+		wantText  string
+		wantClass string
+	}{
+		{"nothing", nil, 0, "", "none"},
+		{
+			"upcoming rounds up",
+			[]calendar.Event{{Title: "Standup", StartDate: at(11*time.Minute + 30*time.Second), EndDate: at(time.Hour)}},
+			0, "Standup in 12m", "upcoming",
+		},
+		{
+			"soon",
+			[]calendar.Event{{Title: "Standup", StartDate: at(4 * time.Minute), EndDate: at(time.Hour)}},
+			0, "Standup in 4m", "soon",
+		},
+		{
+			"ongoing beats upcoming",
+			[]calendar.Event{
+				{Title: "Review", StartDate: at(10 * time.Minute), EndDate: at(time.Hour)},
+				{Title: "1:1 w/ Ana", StartDate: at(-5 * time.Minute), EndDate: at(25 * time.Minute)},
+			},
+			0, "1:1 w/ Ana · 25m left", "ongoing",
+		},
+		{
+			"far away shows the day",
+			[]calendar.Event{{Title: "Offsite", StartDate: at(23 * time.Hour), EndDate: at(25 * time.Hour)}},
+			0, "Offsite Wed 09:00", "upcoming",
+		},
+		{
+			"skips all-day, declined and cancelled",
+			[]calendar.Event{
+				{Title: "Holiday", StartDate: at(-10 * time.Hour), EndDate: at(14 * time.Hour), AllDay: true},
+				{Title: "Declined", StartDate: at(-time.Minute), EndDate: at(time.Hour), SelfStatus: calendar.ParticipantStatusDeclined},
+				{Title: "Cancelled", StartDate: at(5 * time.Minute), EndDate: at(time.Hour), Status: calendar.StatusCanceled},
+				{Title: "Lunch", StartDate: at(2 * time.Hour), EndDate: at(3 * time.Hour)},
+			},
+			0, "Lunch in 2h", "upcoming",
+		},
+		{
+			"max title",
+			[]calendar.Event{{Title: "Quarterly planning review", StartDate: at(time.Hour), EndDate: at(2 * time.Hour)}},
+			10, "Quarterly… in 1h", "upcoming",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := currentOrNextEvent(tt.events, now, nowRelevant)
+			s := newNowStatus(event, now, tt.maxTitle)
+			if s.Text != tt.wantText {
+				t.Errorf("text = %q, want %q", s.Text, tt.wantText)
+			}
+			w := s.waybar()
+			if w.Class != tt.wantClass {
+				t.Errorf("class = %q, want %q", w.Class, tt.wantClass)
+			}
+			if w.Text != tt.wantText {
+				t.Errorf("waybar text = %q, want %q", w.Text, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestNowCache(t *testing.T) {
+	now := time.Date(2026, 3, 17, 10, 0, 0, 0, time.Local)
+	from := time.Date(2026, 3, 17, 0, 0, 0, 0, time.Local)
+	to := from.AddDate(0, 0, 2)
+
+	path := filepath.Join(t.TempDir(), "sub", "now-events.json")
+	if c := readNowCache(path); c.covers("", from, to, now, time.Minute) {
+		t.Fatal("missing cache reported as covering")
+	}
+
+	events := []calendar.Event{{ID: "a", Title: "Standup", StartDate: now, EndDate: now.Add(15 * time.Minute)}}
+	writeNowCache(path, nowCache{FetchedAt: now, From: from, To: to, Events: events})
+	c := readNowCache(path)
+	if c == nil || len(c.Events) != 1 || c.Events[0].Title != "Standup" || !c.Events[0].StartDate.Equal(now) {
+		t.Fatalf("round trip = %+v", c)
+	}
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+		now      time.Time
+		want     bool
+	}{
+		{"fresh", from, to, now.Add(30 * time.Second), true},
+		{"expired", from, to, now.Add(time.Minute), false},
+		{"clock went back", from, to, now.Add(-time.Second), false},
+		{"wider window", from, to.AddDate(0, 0, 1), now, false},
+		{"next day", from.AddDate(0, 0, 1), to.AddDate(0, 0, 1), now, false},
+	}
+	if c.covers(nowCacheQuery(&eventFilter{calendars: []string{"Work"}}), from, to, now, time.Minute) {
+		t.Error("cache fetched without a filter reported as covering a calendar query")
+	}
+	if nowCacheQuery(&eventFilter{excludeCalendars: []string{"Home"}}) != "" {
+		t.Error("client-side filters should not change the cache query")
+	}
+	if entries, _ := os.ReadDir(filepath.Dir(path)); len(entries) != 1 {
+		t.Errorf("temp files left behind: %v", entries)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.covers("", tt.from, tt.to, tt.now, time.Minute); got != tt.want {
+				t.Errorf("covers = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/ical/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	printWeek       bool
+	printMonth      bool
+	printFrom       string
+	printStartDay   string
+	printFormat     string
+	printOutputFile string
+	printFilter     eventFilter
+)
+
+var printCmd = &cobra.Command{
+	Use:   "print",
+	Short: "Make a printable week or month planner page",
+	Long: `Writes a week or month planner as a self-contained page for printing
+or attaching to an email: an HTML page with print styles for one landscape
+sheet, or an SVG image.
+
+--week (the default) lays the seven days out as an hour grid with all-day
+events on top, like 'ical week'. --month draws the month as a calendar
+grid with each day's events. Events are colored by calendar, with a legend
+of the calendars shown at the bottom. Dates, weekday names and the clock
+follow the locale settings, and the usual filters pick the events.`,
+	Example: `  ical print --week > week.html
+  ical print --month --from "next month" --output-file april.html
+  ical print --week --format svg -c Work --output-file week.svg`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if printWeek && printMonth {
+			return fmt.Errorf("use either --week or --month, not both")
+		}
+		if printFormat != "html" && printFormat != "svg" {
+			return fmt.Errorf("invalid --format %q: use html or svg", printFormat)
+		}
+		weekday, err := parseWeekday(printStartDay)
+		if err != nil {
+			return err
+		}
+
+		day := displayNow()
+		if printFrom != "" {
+			day, err = parseDate(printFrom)
+			if err != nil {
+				return fmt.Errorf("invalid --from date: %w", err)
+			}
+		}
+		from := ui.WeekStart(day, weekday)
+		to := from.AddDate(0, 0, 7)
+		if printMonth {
+			from = time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, ui.DisplayLocation())
+			to = from.AddDate(0, 1, 0)
+		}
+
+		client, err := calendar.New()
+		if err != nil {
+			return handleClientError(err)
+		}
+
+		events, err := printFilter.fetch(client, from, to)
+		if err != nil {
+			return fmt.Errorf("failed to list events: %w", err)
+		}
+		sortEvents(events, "start")
+
+		calendars, err := client.Calendars()
+		if err != nil {
+			return fmt.Errorf("failed to fetch calendars: %w", err)
+		}
+
+		w := os.Stdout
+		if printOutputFile != "" {
+			f, err := os.Create(printOutputFile)
+			if err != nil {
+				return fmt.Errorf("failed to create output file: %w", err)
+			}
+			defer f.Close()
+			w = f
+		}
+
+		view := ui.PlannerView{
+			Month:     printMonth,
+			Start:     from,
+			WeekStart: weekday,
+			Events:    events,
+			Calendars: calendars,
+		}
+		if printFormat == "svg" {
+			ui.WritePlannerSVG(w, view)
+		} else {
+			ui.WritePlannerHTML(w, view)
+		}
+		return nil
+	},
+}
+
+func init() {
+	printCmd.Flags().BoolVar(&printWeek, "week", false, "Print a week (default)")
+	printCmd.Flags().BoolVar(&printMonth, "month", false, "Print a month")
+	printCmd.Flags().StringVarP(&printFrom, "from", "f", "", "Any day in the week or month to print (natural language or ISO 8601)")
+	printCmd.Flags().StringVar(&printStartDay, "start-day", "", "First day of the week (default: locale week_start, monday)")
+	printCmd.Flags().StringVar(&printFormat, "format", "html", "Format: html, svg")
+	printCmd.Flags().StringVar(&printOutputFile, "output-file", "", "Write to file instead of stdout")
+	printFilter.addFlags(printCmd, true)
+
+	rootCmd.AddCommand(printCmd)
+}
@@ -0,0 +1,303 @@
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/ical/internal/backup"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var (
+	restoreSource    string
+	restoreCalendars []string
+	restoreDryRun    bool
+	restoreForce     bool
+)
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore [file]",
+	Short: "Restore calendars and events from a backup",
+	Long: `Recreates calendars and events from an archive written by 'ical backup'.
+
+Calendars that already exist (same ID, or else same title, and same account
+when --source is given) are reused; the others are created in --source, or in their original
+account when it exists on this Mac. Events already present in a reused
+calendar (same title and start) are skipped, so a restore can be re-run.
+
+EventKit places events by calendar title, so a calendar whose title is also
+used by another calendar (in any account) is skipped: its events could land
+in the wrong one. Rename one of them and restore again.
+
+Read-only calendars (subscriptions, birthdays) are skipped. Recurring events
+are recreated from their rules, starting where the series started, so edits to single occurrences are not
+restored. Attendees are not re-added, to avoid sending invitations again.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		b, err := backup.Read(args[0])
+		if err != nil {
+			return err
+		}
+
+		client, err := calendar.New()
+		if err != nil {
+			return handleClientError(err)
+		}
+		existing, err := client.Calendars()
+		if err != nil {
+			return fmt.Errorf("failed to fetch calendars: %w", err)
+		}
+
+		steps, err := planRestore(b, existing, restoreSource, restoreCalendars)
+		if err != nil {
+			return err
+		}
+
+		// Skip events that are already there, so re-running a restore
+		// (or restoring over a partially intact account) adds no duplicates.
+		total := 0
+		for i := range steps {
+			s := &steps[i]
+			if s.skip != "" {
+				continue
+			}
+			if s.existingID != "" {
+				current, err := client.Events(inputsFrom(s.inputs, b.Manifest.From), b.Manifest.To, calendar.WithCalendarID(s.existingID))
+				if err != nil {
+					return fmt.Errorf("failed to fetch events in %q: %w", s.target, err)
+				}
+				before := len(s.inputs)
+				s.inputs = dropExistingEvents(s.inputs, current)
+				s.duplicates = before - len(s.inputs)
+			}
+			total += len(s.inputs)
+		}
+
+		printRestorePlan(steps)
+
+		if restoreDryRun {
+			fmt.Printf("Dry run: would create %d events\n", total)
+			return nil
+		}
+		if total == 0 && !stepsCreateCalendars(steps) {
+			fmt.Println("Nothing to restore.")
+			return nil
+		}
+
+		if !restoreForce {
+			fmt.Printf("Restore %d events? [y/N] ", total)
+			reader := bufio.NewReader(os.Stdin)
+			response, _ := reader.ReadString('\n')
+			response = strings.TrimSpace(strings.ToLower(response))
+			if response != "y" && response != "yes" {
+				fmt.Println("Cancelled.")
+				return nil
+			}
+		}
+
+		yellow := color.New(color.FgYellow)
+		created, errors := 0, 0
+		for _, s := range steps {
+			if s.skip != "" {
+				continue
+			}
+			if s.create != nil {
+				if _, err := client.CreateCalendar(*s.create); err != nil {
+					yellow.Fprintf(os.Stderr, "Warning: failed to create calendar %q: %v\n", s.target, err)
+					errors += len(s.inputs)
+					continue
+				}
+			}
+			for _, input := range s.inputs {
+				if _, err := client.CreateEvent(input); err != nil {
+					yellow.Fprintf(os.Stderr, "Warning: failed to create %q: %v\n", input.Title, err)
+					errors++
+					continue
+				}
+				created++
+			}
+		}
+
+		green := color.New(color.FgGreen, color.Bold)
+		green.Printf("Restored %d events", created)
+		if errors > 0 {
+			fmt.Printf(", %d errors", errors)
+		}
+		fmt.Println()
+		return nil
+	},
+}
+
+func init() {
+	restoreCmd.Flags().StringVarP(&restoreSource, "source", "s", "", "Account to restore into (default: each calendar's original account)")
+	restoreCmd.Flags().StringArrayVarP(&restoreCalendars, "calendar", "c", nil, "Only restore these calendars (repeatable)")
+	restoreCmd.Flags().BoolVar(&restoreDryRun, "dry-run", false, "Show what would be restored without changing anything")
+	restoreCmd.Flags().BoolVarP(&restoreForce, "force", "f", false, "Skip confirmation prompt")
+
+	rootCmd.AddCommand(restoreCmd)
+}
+
+// restoreStep is what restore does with one calendar from the archive.
+type restoreStep struct {
+	title      string // title in the archive
+	target     string // calendar the events go to
+	existingID string // set when reusing an existing calendar
+	create     *calendar.CreateCalendarInput
+	skip       string // reason, when the calendar is not restored
+	inputs     []calendar.CreateEventInput
+	duplicates int
+}
+
+// planRestore decides, per archived calendar, whether to reuse an existing
+// calendar, create one, or skip it. only limits the restore to the named
+// calendars. An account that does not exist here is an error, listing the
+// ones that do.
+func planRestore(b *backup.Backup, existing []calendar.Calendar, source string, only []string) ([]restoreStep, error) {
+	sources := make(map[string]string)
+	var sourceNames []string
+	for _, c := range existing {
+		key := strings.ToLower(c.Source)
+		if _, ok := sources[key]; !ok && c.Source != "" {
+			sources[key] = c.Source
+			sourceNames = append(sourceNames, c.Source)
+		}
+	}
+	titles := make(map[string]int)
+	for _, c := range existing {
+		titles[normalizeCalendarName(c.Title)]++
+	}
+	want := make(map[string]bool)
+	for _, n := range normalizeCalendarNames(only) {
+		want[n] = true
+	}
+
+	var steps []restoreStep
+	for _, cd := range b.Calendars {
+		c := cd.Calendar
+		if len(want) > 0 && !want[normalizeCalendarName(c.Title)] {
+			continue
+		}
+		step := restoreStep{title: c.Title, target: c.Title}
+		if c.ReadOnly || c.Type == calendar.CalendarTypeSubscription || c.Type == calendar.CalendarTypeBirthday {
+			step.skip = "read-only"
+			steps = append(steps, step)
+			continue
+		}
+
+		if e := matchCalendar(c, existing, source); e != nil {
+			step.existingID = e.ID
+			step.target = e.Title
+		}
+
+		// Events are created by calendar title, so the target has to be
+		// the only calendar with that title, counting one about to be
+		// created.
+		shared := titles[normalizeCalendarName(step.target)]
+		if step.existingID == "" {
+			shared++
+		}
+		if shared > 1 {
+			step.existingID = ""
+			step.skip = "title used by another calendar"
+			steps = append(steps, step)
+			continue
+		}
+
+		if step.existingID == "" {
+			src := source
+			if src == "" {
+				src = c.Source
+			}
+			resolved, ok := sources[strings.ToLower(src)]
+			if !ok {
+				return nil, fmt.Errorf("account %q for calendar %q not found; pass --source (available: %s)", src, c.Title, strings.Join(sourceNames, ", "))
+			}
+			step.create = &calendar.CreateCalendarInput{Title: c.Title, Source: resolved, Color: c.Color}
+		}
+
+		step.inputs = backup.EventInputs(cd, step.target)
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+// matchCalendar finds the existing calendar to restore c into: the same
+// calendar by ID when it still exists, otherwise one with the same title.
+// With source set, only calendars in that account match.
+func matchCalendar(c calendar.Calendar, existing []calendar.Calendar, source string) *calendar.Calendar {
+	var byTitle *calendar.Calendar
+	for i, e := range existing {
+		if source != "" && !strings.EqualFold(e.Source, source) {
+			continue
+		}
+		if c.ID != "" && e.ID == c.ID {
+			return &existing[i]
+		}
+		if byTitle == nil && normalizeCalendarName(e.Title) == normalizeCalendarName(c.Title) {
+			byTitle = &existing[i]
+		}
+	}
+	return byTitle
+}
+
+// inputsFrom returns the earliest start among inputs, or from when none
+// is earlier. A recurring series is recreated from its first occurrence,
+// which can predate the archive's range.
+func inputsFrom(inputs []calendar.CreateEventInput, from time.Time) time.Time {
+	for _, in := range inputs {
+		if in.StartDate.Before(from) {
+			from = in.StartDate
+		}
+	}
+	return from
+}
+
+// dropExistingEvents removes inputs that match an existing event by title
+// and start time.
+func dropExistingEvents(inputs []calendar.CreateEventInput, existing []calendar.Event) []calendar.CreateEventInput {
+	if len(existing) == 0 {
+		return inputs
+	}
+	have := make(map[string]bool, len(existing))
+	for _, e := range existing {
+		have[e.Title+"\x00"+e.StartDate.UTC().Format("20060102T150405")] = true
+	}
+	out := inputs[:0:0]
+	for _, in := range inputs {
+		if !have[in.Title+"\x00"+in.StartDate.UTC().Format("20060102T150405")] {
+			out = append(out, in)
+		}
+	}
+	return out
+}
+
+func stepsCreateCalendars(steps []restoreStep) bool {
+	for _, s := range steps {
+		if s.skip == "" && s.create != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func printRestorePlan(steps []restoreStep) {
+	for _, s := range steps {
+		switch {
+		case s.skip != "":
+			fmt.Printf("  %s: skip (%s)\n", s.title, s.skip)
+		case s.create != nil:
+			fmt.Printf("  %s: create in %s, %d events\n", s.title, s.create.Source, len(s.inputs))
+		default:
+			line := fmt.Sprintf("  %s: existing calendar, %d events", s.title, len(s.inputs))
+			if s.duplicates > 0 {
+				line += fmt.Sprintf(" (%d already present)", s.duplicates)
+			}
+			fmt.Println(line)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/ical/internal/backup"
+)
+
+func TestPlanRestore(t *testing.T) {
+	b := backup.New([]calendar.Calendar{
+		{ID: "a", Title: "Work", Source: "iCloud"},
+		{ID: "b", Title: "Family", Source: "iCloud", Color: "#1BADF8"},
+		{ID: "c", Title: "Birthdays", Source: "Other", ReadOnly: true},
+		{ID: "d", Title: "Exchange Cal", Source: "Exchange"},
+	}, []calendar.Event{
+		{ID: "e1", Title: "Standup", CalendarID: "a", Calendar: "Work"},
+		{ID: "e2", Title: "Dinner", CalendarID: "b", Calendar: "Family"},
+	}, time.Time{}, time.Time{})
+
+	existing := []calendar.Calendar{
+		{ID: "live-work", Title: "work", Source: "iCloud"},
+		{ID: "live-bd", Title: "Birthdays", Source: "Other"},
+	}
+
+	t.Run("unknown original account", func(t *testing.T) {
+		_, err := planRestore(b, existing, "", nil)
+		if err == nil || !strings.Contains(err.Error(), "Exchange") {
+			t.Errorf("expected account error, got %v", err)
+		}
+	})
+
+	t.Run("into chosen source", func(t *testing.T) {
+		steps, err := planRestore(b, existing, "icloud", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(steps) != 4 {
+			t.Fatalf("expected 4 steps, got %d", len(steps))
+		}
+		work := steps[0]
+		if work.existingID != "live-work" || work.target != "work" || work.create != nil {
+			t.Errorf("work should reuse existing calendar: %+v", work)
+		}
+		if len(work.inputs) != 1 || work.inputs[0].Calendar != "work" {
+			t.Errorf("work inputs: %+v", work.inputs)
+		}
+		family := steps[1]
+		if family.create == nil || family.create.Source != "iCloud" || family.create.Color != "#1BADF8" {
+			t.Errorf("family should be created in iCloud: %+v", family.create)
+		}
+		if steps[2].skip != "read-only" {
+			t.Errorf("birthdays should be skipped: %+v", steps[2])
+		}
+		if steps[3].create == nil || steps[3].create.Source != "iCloud" {
+			t.Errorf("exchange calendar should be created in iCloud: %+v", steps[3])
+		}
+	})
+
+	t.Run("only named calendars", func(t *testing.T) {
+		steps, err := planRestore(b, existing, "", []string{"family"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(steps) != 1 || steps[0].title != "Family" {
+			t.Errorf("steps: %+v", steps)
+		}
+	})
+}
+
+func TestPlanRestore_MatchesByID(t *testing.T) {
+	b := backup.New([]calendar.Calendar{
+		{ID: "a", Title: "Work", Source: "iCloud"},
+	}, nil, time.Time{}, time.Time{})
+
+	// The original calendar was renamed, and another one took its title.
+	existing := []calendar.Calendar{
+		{ID: "other", Title: "Work", Source: "iCloud"},
+		{ID: "a", Title: "Office", Source: "iCloud"},
+	}
+	steps, err := planRestore(b, existing, "", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if steps[0].existingID != "a" || steps[0].target != "Office" {
+		t.Errorf("should reuse the calendar with the same ID: %+v", steps[0])
+	}
+
+	// In another account, the title match is used.
+	existing[0].Source, existing[1].Source = "Exchange", "iCloud"
+	steps, err = planRestore(b, existing, "exchange", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if steps[0].existingID != "other" {
+		t.Errorf("should fall back to the title in --source: %+v", steps[0])
+	}
+}
+
+func TestPlanRestore_SharedTitle(t *testing.T) {
+	b := backup.New([]calendar.Calendar{
+		{ID: "a", Title: "Work", Source: "iCloud"},
+		{ID: "b", Title: "Home", Source: "iCloud"},
+	}, nil, time.Time{}, time.Time{})
+
+	existing := []calendar.Calendar{
+		{ID: "a", Title: "Work", Source: "iCloud"},
+		{ID: "x", Title: "work", Source: "Exchange"},
+		{ID: "y", Title: "Home", Source: "Exchange"},
+	}
+
+	// Work is found by ID but shares its title; Home would be created in
+	// iCloud next to Exchange's Home. Either way events could be placed in
+	// the other account's calendar.
+	steps, err := planRestore(b, existing, "icloud", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, s := range steps {
+		if s.skip == "" || s.create != nil || s.existingID != "" {
+			t.Errorf("%s should be skipped: %+v", s.title, s)
+		}
+	}
+
+	steps, err = planRestore(b, existing[:1], "", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if steps[0].skip != "" || steps[1].skip != "" {
+		t.Errorf("unique titles should not be skipped: %+v", steps)
+	}
+}
+
+func TestInputsFrom(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	if got := inputsFrom(nil, from); !got.Equal(from) {
+		t.Errorf("no inputs: got %v", got)
+	}
+	early := from.AddDate(0, -2, 0)
+	inputs := []calendar.CreateEventInput{{StartDate: from.AddDate(0, 0, 3)}, {StartDate: early}}
+	if got := inputsFrom(inputs, from); !got.Equal(early) {
+		t.Errorf("got %v, want %v", got, early)
+	}
+}
+
+func TestDropExistingEvents(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	inputs := []calendar.CreateEventInput{
+		{Title: "Standup", StartDate: start},
+		{Title: "Standup", StartDate: start.Add(24 * time.Hour)},
+		{Title: "Review", StartDate: start},
+	}
+	existing := []calendar.Event{
+		{Title: "Standup", StartDate: start.In(time.FixedZone("X", 3600))},
+	}
+	got := dropExistingEvents(inputs, existing)
+	if len(got) != 2 || got[0].Title != "Standup" || got[1].Title != "Review" {
+		t.Errorf("got %+v", got)
+	}
+	if len(inputs) != 3 {
+		t.Error("input slice modified")
+	}
+}
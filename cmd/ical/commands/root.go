@@ -3,8 +3,12 @@ package commands
 import (
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/BRO3886/ical/internal/config"
 	"github.com/BRO3886/ical/internal/skills"
+	"github.com/BRO3886/ical/internal/ui"
 	"github.com/BRO3886/ical/internal/update"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -12,6 +16,10 @@ import (
 
 var (
 	outputFormat string
+	templateFile string
+	fieldsSpec   string
+	displayTZ    string
+	outputWidth  int
 	noColor      bool
 )
 
@@ -22,11 +30,49 @@ var rootCmd = &cobra.Command{
 	Use:   "ical",
 	Short: "A fast, native macOS Calendar CLI",
 	Long:  "ical — a fast, native macOS Calendar CLI built on EventKit.\nProvides full CRUD for calendar events, natural language dates,\nrecurrence support, import/export, and multiple output formats.",
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		if noColor || os.Getenv("NO_COLOR") != "" {
 			color.NoColor = true
 		}
 
+		if outputWidth < 0 {
+			return fmt.Errorf("invalid --width %d: must be positive", outputWidth)
+		}
+		ui.SetWidth(outputWidth)
+
+		loc, err := displayLocation(displayTZ, os.Getenv("ICAL_TZ"))
+		if err != nil {
+			return err
+		}
+		ui.SetDisplayLocation(loc)
+
+		if templateFile != "" {
+			data, err := os.ReadFile(templateFile)
+			if err != nil {
+				return fmt.Errorf("failed to read template file: %w", err)
+			}
+			outputFormat = ui.TemplateFormat(string(data))
+		}
+		if err := ui.ValidateTemplate(outputFormat); err != nil {
+			return err
+		}
+
+		cfg, l, problems := loadSettings()
+		for _, err := range problems {
+			color.New(color.FgYellow).Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+		ui.SetLocale(l)
+
+		if fieldsSpec != "" {
+			fields, err := resolveFields(fieldsSpec, cfg)
+			if err != nil {
+				return err
+			}
+			ui.SetEventFields(fields)
+		}
+
+		ui.SetListCommand(strings.Join(append([]string{"ical"}, os.Args[1:]...), " "))
+
 		// Start background update check
 		if shouldCheckForUpdate(cmd) {
 			go func() {
@@ -40,6 +86,7 @@ var rootCmd = &cobra.Command{
 		} else {
 			updateResultCh <- nil
 		}
+		return nil
 	},
 	PersistentPostRun: func(cmd *cobra.Command, args []string) {
 		printUpdateNotice(cmd)
@@ -47,14 +94,115 @@ var rootCmd = &cobra.Command{
 }
 
 func init() {
-	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "Output format: table, json, plain")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "Output format: table, agenda, json, plain, csv, markdown, html, template=<go template>")
+	rootCmd.PersistentFlags().StringVar(&templateFile, "template-file", "", "Read the output template from a file (implies -o template)")
+	rootCmd.PersistentFlags().StringVar(&fieldsSpec, "fields", "", "Event columns to show, comma-separated (e.g. title,start,attendees) or a preset name")
+	rootCmd.PersistentFlags().StringVar(&displayTZ, "tz", "", "Show times and read dates in this IANA time zone (e.g. Asia/Tokyo; also ICAL_TZ)")
 	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable color output")
+	rootCmd.PersistentFlags().IntVar(&outputWidth, "width", 0, "Lay out tables and grids for this many columns (default: terminal width)")
 }
 
 func Execute() error {
 	return rootCmd.Execute()
 }
 
+// resolveFields parses --fields, looking up presets from the built-in set
+// and the fields section of the config file.
+func resolveFields(spec string, cfg *config.Config) ([]ui.Field, error) {
+	fields, err := ui.ParseFields(spec, cfg.Section("fields"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --fields: %w", err)
+	}
+	return fields, nil
+}
+
+// parseLocale builds the display locale from the config file's locale
+// section, starting from the defaults:
+//
+//	[locale]
+//	clock = 12          # or 24
+//	date_order = mdy    # or dmy
+//	week_start = sunday
+//	language = de
+// loadSettings reads the config file and the locale it sets. Problems come
+// back as warnings, with defaults in place, so a typo in the config cannot
+// stop every command (including version, help and now) from running.
+func loadSettings() (*config.Config, ui.Locale, []error) {
+	var problems []error
+	cfg, err := config.Load()
+	if err != nil {
+		problems = append(problems, fmt.Errorf("%w; ignoring the config file", err))
+		cfg = &config.Config{}
+	}
+	l, err := parseLocale(cfg.Section("locale"))
+	if err != nil {
+		problems = append(problems, fmt.Errorf("%w; using the default locale", err))
+		l = ui.DefaultLocale()
+	}
+	return cfg, l, problems
+}
+
+func parseLocale(settings map[string]string) (ui.Locale, error) {
+	l := ui.DefaultLocale()
+	for key, value := range settings {
+		switch key {
+		case "clock":
+			switch strings.TrimSuffix(strings.ToLower(value), "h") {
+			case "12":
+				l.Clock12 = true
+			case "24":
+				l.Clock12 = false
+			default:
+				return l, fmt.Errorf("invalid locale.clock %q: use 12 or 24", value)
+			}
+		case "date_order":
+			switch strings.ToLower(value) {
+			case "mdy":
+				l.MonthFirst = true
+			case "dmy":
+				l.MonthFirst = false
+			default:
+				return l, fmt.Errorf("invalid locale.date_order %q: use dmy or mdy", value)
+			}
+		case "week_start":
+			if strings.TrimSpace(value) == "" {
+				return l, fmt.Errorf("invalid locale.week_start: empty")
+			}
+			d, err := parseWeekday(value)
+			if err != nil {
+				return l, fmt.Errorf("invalid locale.week_start %q: use a weekday name like monday or sun", value)
+			}
+			l.WeekStart = d
+		case "language":
+			lang, err := ui.ParseLanguage(value)
+			if err != nil {
+				return l, fmt.Errorf("invalid locale.language: %w", err)
+			}
+			l.Language = lang
+		default:
+			return l, fmt.Errorf("unknown config key locale.%s (use clock, date_order, week_start, language)", key)
+		}
+	}
+	return l, nil
+}
+
+// displayLocation resolves --tz, falling back to ICAL_TZ. It returns nil
+// when neither is set.
+func displayLocation(flag, env string) (*time.Location, error) {
+	name, source := flag, "--tz"
+	if name == "" {
+		name, source = env, "ICAL_TZ"
+	}
+	if name == "" {
+		return nil, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q: use an IANA zone name like Asia/Tokyo", source, name)
+	}
+	return loc, nil
+}
+
 // shouldCheckForUpdate returns false for commands/contexts where the check should be skipped.
 func shouldCheckForUpdate(cmd *cobra.Command) bool {
 	// Skip if env var set
@@ -69,12 +217,16 @@ func shouldCheckForUpdate(cmd *cobra.Command) bool {
 
 	// Skip for meta commands
 	name := cmd.Name()
-	if name == "version" || name == "completion" || name == "skills" {
+	if name == "version" || name == "completion" || name == "skills" || name == "now" {
 		return false
 	}
 
-	// Skip if --output json (scripting context)
-	if outputFormat == "json" {
+	// Skip for machine and paste formats, and templates (scripting context)
+	switch outputFormat {
+	case "json", "csv", "markdown", "html":
+		return false
+	}
+	if ui.IsTemplateFormat(outputFormat) {
 		return false
 	}
 
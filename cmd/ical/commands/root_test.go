@@ -0,0 +1,151 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/ical/internal/ui"
+)
+
+func TestDisplayLocation(t *testing.T) {
+	tests := []struct {
+		name    string
+		flag    string
+		env     string
+		want    string // "" means nil
+		wantErr bool
+	}{
+		{"unset", "", "", "", false},
+		{"flag", "Asia/Tokyo", "", "Asia/Tokyo", false},
+		{"env", "", "Europe/Madrid", "Europe/Madrid", false},
+		{"flag wins over env", "UTC", "Europe/Madrid", "UTC", false},
+		{"invalid flag", "Mars/Olympus", "", "", true},
+		{"invalid env", "", "PST", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := displayLocation(tt.flag, tt.env)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			got := ""
+			if loc != nil {
+				got = loc.String()
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDateInDisplayZone(t *testing.T) {
+	london, err1 := time.LoadLocation("Europe/London")
+	tokyo, err2 := time.LoadLocation("Asia/Tokyo")
+	if err1 != nil || err2 != nil {
+		t.Skip("no tzdata")
+	}
+	defer func(l *time.Location) { time.Local = l }(time.Local)
+	time.Local = london
+	ui.SetDisplayLocation(tokyo)
+	defer ui.SetDisplayLocation(nil)
+
+	got, err := parseDate("2026-03-17 09:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2026, 3, 17, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("parseDate = %v, want 09:00 in Tokyo (%v)", got, want)
+	}
+	if d := startOfDay(displayNow()); d.Location() != tokyo {
+		t.Errorf("today starts in %v, want Asia/Tokyo", d.Location())
+	}
+
+	// An all-day date read in Tokyo keeps its day in the system zone.
+	day, _ := parseDate("2026-03-17")
+	if f := floatingTime(day); f.Location() != london || f.Day() != 17 || f.Hour() != 0 {
+		t.Errorf("floatingTime = %v, want 17 March 00:00 in London", f)
+	}
+}
+
+func TestParseLocale(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings map[string]string
+		want     ui.Locale
+		wantErr  bool
+	}{
+		{"defaults", nil, ui.DefaultLocale(), false},
+		{
+			"all keys",
+			map[string]string{"clock": "12h", "date_order": "MDY", "week_start": "sun", "language": "fr"},
+			ui.Locale{Clock12: true, MonthFirst: true, WeekStart: time.Sunday, Language: "fr"},
+			false,
+		},
+		{"24h clock", map[string]string{"clock": "24"}, ui.DefaultLocale(), false},
+		{"bad clock", map[string]string{"clock": "13"}, ui.Locale{}, true},
+		{"bad date order", map[string]string{"date_order": "ymd"}, ui.Locale{}, true},
+		{"bad week start", map[string]string{"week_start": "funday"}, ui.Locale{}, true},
+		{"empty week start", map[string]string{"week_start": ""}, ui.Locale{}, true},
+		{"bad language", map[string]string{"language": "tlh"}, ui.Locale{}, true},
+		{"unknown key", map[string]string{"timezone": "UTC"}, ui.Locale{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseLocale(tt.settings)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadSettings(t *testing.T) {
+	write := func(t *testing.T, content string) {
+		dir := t.TempDir()
+		t.Setenv("XDG_CONFIG_HOME", dir)
+		if err := os.MkdirAll(filepath.Join(dir, "ical"), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "ical", "config"), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		write(t, "locale.clock = 12\nfields.mine = title\n")
+		cfg, l, problems := loadSettings()
+		if len(problems) != 0 || !l.Clock12 {
+			t.Errorf("locale %+v, problems %v", l, problems)
+		}
+		if _, ok := cfg.Get("fields.mine"); !ok {
+			t.Error("preset not loaded")
+		}
+	})
+
+	t.Run("unparsable file", func(t *testing.T) {
+		write(t, "locale.clock = 12\nno equals sign\n")
+		cfg, l, problems := loadSettings()
+		if len(problems) != 1 || cfg == nil || l != ui.DefaultLocale() {
+			t.Errorf("locale %+v, problems %v", l, problems)
+		}
+	})
+
+	t.Run("bad locale", func(t *testing.T) {
+		write(t, "locale.clock = 13\nfields.mine = title\n")
+		cfg, l, problems := loadSettings()
+		if len(problems) != 1 || l != ui.DefaultLocale() {
+			t.Errorf("locale %+v, problems %v", l, problems)
+		}
+		if _, ok := cfg.Get("fields.mine"); !ok {
+			t.Error("presets should survive a bad locale")
+		}
+	})
+}
@@ -3,21 +3,17 @@ package commands
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/BRO3886/go-eventkit/calendar"
-	"github.com/BRO3886/go-eventkit/dateparser"
 	"github.com/BRO3886/ical/internal/ui"
 	"github.com/spf13/cobra"
 )
 
 var (
-	searchFrom        string
-	searchTo          string
-	searchCalendars   []string
-	searchLimit       int
-	searchAttendee    string
-	searchNoRecurring bool
+	searchFrom   string
+	searchTo     string
+	searchFilter eventFilter
+	searchLimit  int
 )
 
 var searchCmd = &cobra.Command{
@@ -29,10 +25,10 @@ var searchCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		query := strings.Join(args, " ")
 
-		now := time.Now()
+		now := displayNow()
 		from := now.AddDate(0, 0, -30) // 30 days ago
 		if searchFrom != "" {
-			t, err := dateparser.ParseDate(searchFrom)
+			t, err := parseDate(searchFrom)
 			if err != nil {
 				return fmt.Errorf("invalid --from date: %w", err)
 			}
@@ -41,7 +37,7 @@ var searchCmd = &cobra.Command{
 
 		to := now.AddDate(0, 0, 30) // 30 days ahead
 		if searchTo != "" {
-			t, err := dateparser.ParseDate(searchTo)
+			t, err := parseDate(searchTo)
 			if err != nil {
 				return fmt.Errorf("invalid --to date: %w", err)
 			}
@@ -53,49 +49,26 @@ var searchCmd = &cobra.Command{
 			return handleClientError(err)
 		}
 
-		opts := []calendar.ListOption{calendar.WithSearch(query)}
-		normalized := normalizeCalendarNames(searchCalendars)
-		if len(normalized) == 1 {
-			opts = append(opts, calendar.WithCalendar(normalized[0]))
-		} else if len(normalized) > 1 {
-			opts = append(opts, calendar.WithCalendars(normalized))
-		}
-
-		events, err := client.Events(from, to, opts...)
+		searchFilter.search = query
+		events, err := searchFilter.fetch(client, from, to)
 		if err != nil {
 			return fmt.Errorf("failed to search events: %w", err)
 		}
 
-		if searchAttendee != "" {
-			filtered := make([]calendar.Event, 0, len(events))
-			for _, e := range events {
-				if attendeeMatches(e, searchAttendee) {
-					filtered = append(filtered, e)
-				}
-			}
-			events = filtered
-		}
-
-		if searchNoRecurring {
-			events = filterRecurring(events)
-		}
-
 		if searchLimit > 0 && len(events) > searchLimit {
 			events = events[:searchLimit]
 		}
 
-		ui.PrintEvents(events, outputFormat)
-		return nil
+		loadCalendarColors(client, outputFormat)
+		return ui.PrintEvents(events, outputFormat)
 	},
 }
 
 func init() {
 	searchCmd.Flags().StringVarP(&searchFrom, "from", "f", "", "Start of search range (default: 30 days ago)")
 	searchCmd.Flags().StringVarP(&searchTo, "to", "t", "", "End of search range (default: 30 days ahead)")
-	searchCmd.Flags().StringArrayVarP(&searchCalendars, "calendar", "c", nil, "Filter by calendar name (repeatable)")
+	searchFilter.addFlags(searchCmd, false)
 	searchCmd.Flags().IntVarP(&searchLimit, "limit", "n", 0, "Max results")
-	searchCmd.Flags().StringVarP(&searchAttendee, "attendee", "a", "", "Filter by attendee or organizer name/email")
-	searchCmd.Flags().BoolVar(&searchNoRecurring, "no-recurring", false, "Hide recurring events")
 
 	rootCmd.AddCommand(searchCmd)
 }
@@ -3,12 +3,14 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/BRO3886/ical/internal/ui"
 	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
@@ -63,8 +65,8 @@ or a full/partial event ID. Use --id for exact event ID lookup (no prefix matchi
 			}
 		}
 
-		ui.PrintEventDetail(event, outputFormat)
-		return nil
+		loadCalendarColors(client, outputFormat)
+		return ui.PrintEventDetail(event, outputFormat)
 	},
 }
 
@@ -77,17 +79,40 @@ func init() {
 	rootCmd.AddCommand(showCmd)
 }
 
+// eventForRow fetches the event behind a row number. It warns when the
+// listing is old and refuses when the event is gone or no longer looks
+// like what was listed, since acting on the wrong event can't be undone.
+func eventForRow(client *calendar.Client, list *ui.LastList, n int, row ui.ListedRow) (*calendar.Event, error) {
+	now := time.Now()
+	if list.Stale(now) {
+		yellow := color.New(color.FgYellow)
+		yellow.Fprintf(os.Stderr, "Warning: row numbers are from `%s`, %s ago\n", list.Command, ui.CompactDuration(now.Sub(list.SavedAt)))
+	}
+
+	event, err := client.Event(row.ID)
+	if errors.Is(err, calendar.ErrNotFound) {
+		return nil, fmt.Errorf("row %d (%q) no longer exists; run `%s` again", n, row.Title, list.Command)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch event: %w", err)
+	}
+	if !row.Matches(event) {
+		loc := ui.CurrentLocale()
+		return nil, fmt.Errorf("row %d has changed since `%s`: listed as %q at %s, now %q at %s; list again or pass the event ID",
+			n, list.Command, row.Title, loc.DateTime(row.Start.In(ui.DisplayLocation())), event.Title, loc.DateTime(ui.DisplayTime(event.StartDate, event.AllDay)))
+	}
+	return event, nil
+}
+
 // findEventByPrefix finds an event by row number from the last listing,
 // by exact ID, or by ID prefix matching.
 func findEventByPrefix(client *calendar.Client, input string) (*calendar.Event, error) {
 	// Check if input is a row number (e.g. "1", "2") from the last listing
 	if n, err := strconv.Atoi(input); err == nil && n > 0 {
-		if id := ui.LookupRowNumber(n); id != "" {
-			event, err := client.Event(id)
-			if err == nil {
-				return event, nil
+		if list := ui.LoadLastList(); list != nil {
+			if row, ok := list.Row(n); ok {
+				return eventForRow(client, list, n, row)
 			}
-			// Event may have been deleted since listing; fall through to search
 		}
 	}
 
@@ -125,7 +150,7 @@ func findEventByPrefix(client *calendar.Client, input string) (*calendar.Event,
 		var sb strings.Builder
 		fmt.Fprintf(&sb, "Multiple events match %q. Be more specific:\n", input)
 		for _, m := range matches {
-			fmt.Fprintf(&sb, "  %s  %s (%s)\n", m.ID, m.Title, m.StartDate.Format("Jan 02 15:04"))
+			fmt.Fprintf(&sb, "  %s  %s (%s)\n", m.ID, m.Title, ui.CurrentLocale().DateTime(ui.DisplayTime(m.StartDate, m.AllDay)))
 		}
 		return nil, fmt.Errorf("%s", sb.String())
 	}
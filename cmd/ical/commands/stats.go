@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/ical/internal/stats"
+	"github.com/BRO3886/ical/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	statsFrom     string
+	statsTo       string
+	statsWorkday  string
+	statsMinFocus time.Duration
+	statsTop      int
+	statsFilter   eventFilter
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Summarize where your time went over a date range",
+	Long: `Reports how the time in a date range is spent: hours per calendar, per
+organizer and per attendee, meetings against focus time, the recurring and
+one-off share, average meeting length, the busiest weekdays and hours, and
+week-over-week totals.
+
+Only time you are committed to counts: all-day events, events marked free,
+declined invitations and cancelled events are skipped. A meeting is an
+event with attendees. Focus time is the free stretches of at least
+--min-focus inside the --workday on Monday to Friday.
+
+Defaults to the last four weeks.`,
+	Example: `  ical stats
+  ical stats -f "1 quarter ago" -t today -o json
+  ical stats -c Work --workday 08:30-17:30 --min-focus 90m`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		from, to, err := lookbackRange(statsFrom, statsTo)
+		if err != nil {
+			return err
+		}
+
+		dayStart, dayEnd, err := parseWorkday(statsWorkday)
+		if err != nil {
+			return err
+		}
+
+		client, err := calendar.New()
+		if err != nil {
+			return handleClientError(err)
+		}
+
+		events, err := statsFilter.fetch(client, from, to)
+		if err != nil {
+			return fmt.Errorf("failed to list events: %w", err)
+		}
+
+		report := stats.Compute(events, stats.Options{
+			From:      from,
+			To:        to,
+			DayStart:  dayStart,
+			DayEnd:    dayEnd,
+			MinFocus:  statsMinFocus,
+			WeekStart: ui.CurrentLocale().WeekStart,
+			Top:       statsTop,
+		})
+		loadCalendarColors(client, outputFormat)
+		ui.PrintStats(report, outputFormat)
+		return nil
+	},
+}
+
+func init() {
+	statsCmd.Flags().StringVarP(&statsFrom, "from", "f", "", "Start date (default: 4 weeks before --to)")
+	statsCmd.Flags().StringVarP(&statsTo, "to", "t", "", "End date (default: end of today)")
+	statsCmd.Flags().StringVar(&statsWorkday, "workday", "09:00-17:00", "Working hours for focus time, as HH:MM-HH:MM")
+	statsCmd.Flags().DurationVar(&statsMinFocus, "min-focus", time.Hour, "Shortest free stretch that counts as focus time")
+	statsCmd.Flags().IntVar(&statsTop, "top", 10, "Rows per calendar/organizer/attendee table (0 = all)")
+	statsFilter.addFlags(statsCmd, true)
+
+	rootCmd.AddCommand(statsCmd)
+}
+
+// lookbackRange parses --from and --to for reports over past time. --to
+// defaults to the end of today and --from to four weeks before --to.
+func lookbackRange(fromStr, toStr string) (time.Time, time.Time, error) {
+	to := startOfDay(displayNow()).AddDate(0, 0, 1)
+	if toStr != "" {
+		t, err := parseDate(toStr)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --to date: %w", err)
+		}
+		to = endOfDayIfMidnight(t)
+	}
+
+	from := to.AddDate(0, 0, -28)
+	if fromStr != "" {
+		t, err := parseDate(fromStr)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --from date: %w", err)
+		}
+		from = t
+	}
+	if !to.After(from) {
+		return time.Time{}, time.Time{}, fmt.Errorf("--to must be after --from")
+	}
+	return from, to, nil
+}
+
+// parseWorkday parses "09:00-17:00" into offsets from midnight.
+func parseWorkday(s string) (time.Duration, time.Duration, error) {
+	startStr, endStr, ok := strings.Cut(s, "-")
+	if ok {
+		start, err1 := time.Parse("15:04", strings.TrimSpace(startStr))
+		end, err2 := time.Parse("15:04", strings.TrimSpace(endStr))
+		if err1 == nil && err2 == nil && end.After(start) {
+			midnight := time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)
+			return start.Sub(midnight), end.Sub(midnight), nil
+		}
+	}
+	return 0, 0, fmt.Errorf("invalid --workday %q: use HH:MM-HH:MM, e.g. 09:00-17:00", s)
+}
@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWorkday(t *testing.T) {
+	tests := []struct {
+		in         string
+		start, end time.Duration
+		wantErr    bool
+	}{
+		{"09:00-17:00", 9 * time.Hour, 17 * time.Hour, false},
+		{"8:30 - 17:45", 8*time.Hour + 30*time.Minute, 17*time.Hour + 45*time.Minute, false},
+		{"17:00-09:00", 0, 0, true},
+		{"9-5", 0, 0, true},
+		{"", 0, 0, true},
+	}
+	for _, tt := range tests {
+		start, end, err := parseWorkday(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseWorkday(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if start != tt.start || end != tt.end {
+			t.Errorf("parseWorkday(%q) = %v, %v; want %v, %v", tt.in, start, end, tt.start, tt.end)
+		}
+	}
+}
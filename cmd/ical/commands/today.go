@@ -1,17 +1,16 @@
 package commands
 
 import (
-	"time"
-
 	"github.com/spf13/cobra"
 )
 
 var todayCmd = &cobra.Command{
 	Use:   "today",
 	Short: "Show today's events",
-	Long:  "Shortcut for 'cal list --from today --to tomorrow'. Shows the day's agenda.",
+	Long:  "Shortcut for 'cal list --from today --to tomorrow'. Shows the day's agenda.\nOn a terminal the default output is -o agenda; use -o table for the table.",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		now := time.Now()
+		agendaByDefault(cmd)
+		now := displayNow()
 		from := startOfDay(now)
 		to := startOfDay(now.AddDate(0, 0, 1))
 		return listEvents(from, to)
@@ -19,15 +18,9 @@ var todayCmd = &cobra.Command{
 }
 
 func init() {
-	todayCmd.Flags().StringArrayVarP(&listCalendars, "calendar", "c", nil, "Filter by calendar name (repeatable)")
-	todayCmd.Flags().StringVar(&listCalendarID, "calendar-id", "", "Filter by calendar ID")
-	todayCmd.Flags().StringVarP(&listSearch, "search", "s", "", "Search title, location, notes")
-	todayCmd.Flags().BoolVar(&listAllDay, "all-day", false, "Show only all-day events")
+	listFilter.addFlags(todayCmd, true)
 	todayCmd.Flags().StringVar(&listSort, "sort", "start", "Sort by: start, end, title, calendar")
 	todayCmd.Flags().IntVarP(&listLimit, "limit", "n", 0, "Max events to display")
-	todayCmd.Flags().StringArrayVar(&listExcludeCalendar, "exclude-calendar", nil, "Exclude calendars by name (repeatable)")
-	todayCmd.Flags().StringVarP(&listAttendee, "attendee", "a", "", "Filter by attendee or organizer name/email")
-	todayCmd.Flags().BoolVar(&listNoRecurring, "no-recurring", false, "Hide recurring events")
 
 	rootCmd.AddCommand(todayCmd)
 }
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/ical/internal/tui"
+	"github.com/BRO3886/ical/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	tuiView     string
+	tuiStartDay string
+)
+
+var tuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "Browse and edit the calendar in a full-screen terminal UI",
+	Long: `Opens a full-screen calendar with day, week and agenda views.
+
+Keys:
+  ←/→ h/l     previous/next day        [/]  previous/next week
+  ↑/↓ k/j     select event              t    jump to today
+  1 2 3, tab  day / week / agenda view  enter  toggle the detail panel
+  a           add an event on the selected day
+  e           edit the selected event
+  d           delete (recurring events ask for this or future events)
+  r           RSVP: accept, decline or tentative
+  J           join the selected event's conference link
+  R           reload                    q    quit
+
+Add and edit open the same forms as 'ical add -i' and 'ical update -i'.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pane, err := tui.ParsePane(tuiView)
+		if err != nil {
+			return err
+		}
+		weekday, err := parseWeekday(tuiStartDay)
+		if err != nil {
+			return err
+		}
+
+		client, err := calendar.New()
+		if err != nil {
+			return handleClientError(err)
+		}
+		calendars, err := client.Calendars()
+		if err != nil {
+			return fmt.Errorf("failed to fetch calendars: %w", err)
+		}
+
+		return tui.Run(tui.Options{
+			Backend: client,
+			Actions: tui.Actions{
+				Add: func(start time.Time) error {
+					return runAddInteractive(start.Format("2006-01-02 15:04"))
+				},
+				Edit: func(e *calendar.Event) error {
+					return runUpdateInteractive(client, e)
+				},
+				Join: func(url string) error {
+					return exec.Command("open", url).Run()
+				},
+			},
+			Colors:    ui.NewCalendarColors(calendars),
+			WeekStart: weekday,
+			Pane:      pane,
+		})
+	},
+}
+
+func init() {
+	tuiCmd.Flags().StringVar(&tuiView, "view", "week", "Initial view: day, week, agenda")
+	tuiCmd.Flags().StringVar(&tuiStartDay, "start-day", "", "First day of the week (default: locale week_start, monday)")
+
+	rootCmd.AddCommand(tuiCmd)
+}
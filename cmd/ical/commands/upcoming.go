@@ -1,8 +1,6 @@
 package commands
 
 import (
-	"time"
-
 	"github.com/spf13/cobra"
 )
 
@@ -12,9 +10,10 @@ var upcomingCmd = &cobra.Command{
 	Use:     "upcoming",
 	Aliases: []string{"next", "soon"},
 	Short:   "Show events in the next N days",
-	Long:    "Shortcut for 'cal list' with --from today --to 'in N days'.",
+	Long:    "Shortcut for 'cal list' with --from today --to 'in N days'.\nOn a terminal the default output is -o agenda; use -o table for the table.",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		now := time.Now()
+		agendaByDefault(cmd)
+		now := displayNow()
 		from := startOfDay(now)
 		to := startOfDay(now.AddDate(0, 0, upcomingDays))
 		return listEvents(from, to)
@@ -23,15 +22,9 @@ var upcomingCmd = &cobra.Command{
 
 func init() {
 	upcomingCmd.Flags().IntVarP(&upcomingDays, "days", "d", 7, "Number of days to look ahead")
-	upcomingCmd.Flags().StringArrayVarP(&listCalendars, "calendar", "c", nil, "Filter by calendar name (repeatable)")
-	upcomingCmd.Flags().StringVar(&listCalendarID, "calendar-id", "", "Filter by calendar ID")
-	upcomingCmd.Flags().StringVarP(&listSearch, "search", "s", "", "Search title, location, notes")
-	upcomingCmd.Flags().BoolVar(&listAllDay, "all-day", false, "Show only all-day events")
+	listFilter.addFlags(upcomingCmd, true)
 	upcomingCmd.Flags().StringVar(&listSort, "sort", "start", "Sort by: start, end, title, calendar")
 	upcomingCmd.Flags().IntVarP(&listLimit, "limit", "n", 0, "Max events to display")
-	upcomingCmd.Flags().StringArrayVar(&listExcludeCalendar, "exclude-calendar", nil, "Exclude calendars by name (repeatable)")
-	upcomingCmd.Flags().StringVarP(&listAttendee, "attendee", "a", "", "Filter by attendee or organizer name/email")
-	upcomingCmd.Flags().BoolVar(&listNoRecurring, "no-recurring", false, "Hide recurring events")
 
 	rootCmd.AddCommand(upcomingCmd)
 }
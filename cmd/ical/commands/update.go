@@ -87,14 +87,14 @@ Use -i for interactive mode with guided prompts.`,
 			input.Title = strPtr(updateTitle)
 		}
 		if cmd.Flags().Changed("start") {
-			t, err := dateparser.ParseDate(updateStart)
+			t, err := parseDate(updateStart)
 			if err != nil {
 				return fmt.Errorf("invalid --start: %w", err)
 			}
 			input.StartDate = &t
 		}
 		if cmd.Flags().Changed("end") {
-			t, err := dateparser.ParseDate(updateEnd)
+			t, err := parseDate(updateEnd)
 			if err != nil {
 				return fmt.Errorf("invalid --end: %w", err)
 			}
@@ -104,6 +104,14 @@ Use -i for interactive mode with guided prompts.`,
 			b := updateAllDay == "true"
 			input.AllDay = &b
 		}
+		if (input.AllDay != nil && *input.AllDay) || (input.AllDay == nil && event.AllDay) {
+			if input.StartDate != nil {
+				*input.StartDate = floatingTime(*input.StartDate)
+			}
+			if input.EndDate != nil {
+				*input.EndDate = floatingTime(*input.EndDate)
+			}
+		}
 		if cmd.Flags().Changed("calendar") {
 			input.Calendar = strPtr(updateCalendar)
 		}
@@ -257,7 +265,7 @@ func runUpdateInteractive(client *calendar.Client, event *calendar.Event) error
 				if strings.TrimSpace(s) == "" {
 					return fmt.Errorf("start date is required")
 				}
-				_, err := dateparser.ParseDate(s)
+				_, err := parseDate(s)
 				if err != nil {
 					return fmt.Errorf("invalid date: %v", err)
 				}
@@ -271,7 +279,7 @@ func runUpdateInteractive(client *calendar.Client, event *calendar.Event) error
 				if strings.TrimSpace(s) == "" {
 					return nil
 				}
-				_, err := dateparser.ParseDate(s)
+				_, err := parseDate(s)
 				if err != nil {
 					return fmt.Errorf("invalid date: %v", err)
 				}
@@ -347,13 +355,19 @@ func runUpdateInteractive(client *calendar.Client, event *calendar.Event) error
 		input.Calendar = strPtr(calName)
 	}
 
-	newStart, _ := dateparser.ParseDate(startStr) // validated above
+	newStart, _ := parseDate(startStr) // validated above
+	if allDay {
+		newStart = floatingTime(newStart)
+	}
 	if !newStart.Equal(event.StartDate) {
 		input.StartDate = &newStart
 	}
 
 	if strings.TrimSpace(endStr) != "" {
-		newEnd, _ := dateparser.ParseDate(endStr) // validated above
+		newEnd, _ := parseDate(endStr) // validated above
+		if allDay {
+			newEnd = floatingTime(newEnd)
+		}
 		if !newEnd.Equal(event.EndDate) {
 			input.EndDate = &newEnd
 		}
@@ -464,7 +478,7 @@ func localizeEventTime(t time.Time, tz string) time.Time {
 			return t.In(loc)
 		}
 	}
-	return t.In(time.Local)
+	return t.In(ui.DisplayLocation())
 }
 
 func strPtr(s string) *string {
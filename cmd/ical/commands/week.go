@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/ical/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	weekFrom     string
+	weekStartDay string
+	weekFilter   eventFilter
+)
+
+var weekCmd = &cobra.Command{
+	Use:   "week",
+	Short: "Show a week as a day-by-hour grid",
+	Long: `Show seven days side by side with events placed in half-hour rows.
+All-day events sit above the grid, overlapping events share their day's
+column, and the current time is marked in red. Events are tinted with their
+calendar's color.
+
+--from picks any day in the week to show (default: today). The grid fits
+the terminal width; set --width or COLUMNS to override. With -o json, plain,
+csv or a template, the week's events are printed as a list instead.`,
+	Example: `  ical week
+  ical week --from "next week"
+  ical week --start-day sunday --exclude-calendar Birthdays`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		weekday, err := parseWeekday(weekStartDay)
+		if err != nil {
+			return err
+		}
+
+		now := displayNow()
+		day := now
+		if weekFrom != "" {
+			day, err = parseDate(weekFrom)
+			if err != nil {
+				return fmt.Errorf("invalid --from date: %w", err)
+			}
+		}
+		from := ui.WeekStart(day, weekday)
+		to := from.AddDate(0, 0, 7)
+
+		client, err := calendar.New()
+		if err != nil {
+			return handleClientError(err)
+		}
+
+		events, err := weekFilter.fetch(client, from, to)
+		if err != nil {
+			return fmt.Errorf("failed to list events: %w", err)
+		}
+		sortEvents(events, "start")
+
+		if outputFormat != "table" {
+			loadCalendarColors(client, outputFormat)
+			return ui.PrintEvents(events, outputFormat)
+		}
+
+		calendars, err := client.Calendars()
+		if err != nil {
+			return fmt.Errorf("failed to fetch calendars: %w", err)
+		}
+
+		ui.RenderWeek(os.Stdout, ui.WeekView{
+			Start:  from,
+			Events: events,
+			Colors: ui.NewCalendarColors(calendars),
+			Now:    now,
+			Width:  ui.TerminalWidth(),
+		})
+		return nil
+	},
+}
+
+func init() {
+	weekCmd.Flags().StringVarP(&weekFrom, "from", "f", "", "Any day in the week to show (natural language or ISO 8601)")
+	weekCmd.Flags().StringVar(&weekStartDay, "start-day", "", "First day of the week (default: locale week_start, monday)")
+	weekFilter.addFlags(weekCmd, true)
+
+	rootCmd.AddCommand(weekCmd)
+}
+
+// parseWeekday accepts full or three-letter English weekday names. An
+// empty name means the locale's week start.
+func parseWeekday(s string) (time.Weekday, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	if name == "" {
+		return ui.CurrentLocale().WeekStart, nil
+	}
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		full := strings.ToLower(d.String())
+		if name == full || (len(name) >= 3 && strings.HasPrefix(full, name)) {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid --start-day %q: use a weekday name like monday or sun", s)
+}
@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWeekday(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Weekday
+		wantErr bool
+	}{
+		{input: "monday", want: time.Monday},
+		{input: "Sunday", want: time.Sunday},
+		{input: "sat", want: time.Saturday},
+		{input: " thu ", want: time.Thursday},
+		{input: "tues", want: time.Tuesday},
+		{input: "mo", wantErr: true},
+		{input: "funday", wantErr: true},
+		{input: "", want: time.Monday}, // the default locale's week start
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseWeekday(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseWeekday(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package backup
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+const manifestFile = "manifest.json"
+
+// archiveKind picks the container format from the file name.
+func archiveKind(filename string) (string, error) {
+	lower := strings.ToLower(filename)
+	switch {
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		return "tar.gz", nil
+	case strings.HasSuffix(lower, ".zip"):
+		return "zip", nil
+	default:
+		return "", fmt.Errorf("unsupported archive %q (use .tar.gz, .tgz, or .zip)", filename)
+	}
+}
+
+// Write saves b to filename as a tar.gz or zip archive, chosen by the file
+// extension.
+func Write(b *Backup, filename string) error {
+	kind, err := archiveKind(filename)
+	if err != nil {
+		return err
+	}
+
+	files, err := b.files()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create archive: %w", err)
+	}
+	if kind == "zip" {
+		err = writeZip(f, files, b.Manifest.CreatedAt)
+	} else {
+		err = writeTarGz(f, files, b.Manifest.CreatedAt)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(filename)
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+	return nil
+}
+
+type archiveFile struct {
+	name string
+	data []byte
+}
+
+// files serializes the manifest and one file per calendar, manifest first
+// so tools that stream the archive see the summary before the data.
+func (b *Backup) files() ([]archiveFile, error) {
+	manifest, err := json.MarshalIndent(b.Manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	files := []archiveFile{{manifestFile, manifest}}
+	for i, cd := range b.Calendars {
+		data, err := json.MarshalIndent(cd, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode calendar %q: %w", cd.Calendar.Title, err)
+		}
+		files = append(files, archiveFile{b.Manifest.Calendars[i].File, data})
+	}
+	return files, nil
+}
+
+func writeTarGz(w io.Writer, files []archiveFile, modTime time.Time) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	for _, f := range files {
+		hdr := &tar.Header{
+			Name:    f.name,
+			Mode:    0o644,
+			Size:    int64(len(f.data)),
+			ModTime: modTime,
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err := tw.Write(f.data); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func writeZip(w io.Writer, files []archiveFile, modTime time.Time) error {
+	zw := zip.NewWriter(w)
+	for _, f := range files {
+		fw, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     f.name,
+			Method:   zip.Deflate,
+			Modified: modTime,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := fw.Write(f.data); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// Read loads an archive written by Write.
+func Read(filename string) (*Backup, error) {
+	kind, err := archiveKind(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var files map[string][]byte
+	if kind == "zip" {
+		files, err = readZip(filename)
+	} else {
+		files, err = readTarGz(filename)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+
+	raw, ok := files[manifestFile]
+	if !ok {
+		return nil, fmt.Errorf("archive has no %s; not an ical backup?", manifestFile)
+	}
+	b := &Backup{}
+	if err := json.Unmarshal(raw, &b.Manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	if b.Manifest.Version > Version {
+		return nil, fmt.Errorf("backup version %d is newer than supported version %d; upgrade ical", b.Manifest.Version, Version)
+	}
+
+	for _, summary := range b.Manifest.Calendars {
+		raw, ok := files[summary.File]
+		if !ok {
+			return nil, fmt.Errorf("archive is missing %s (calendar %q)", summary.File, summary.Title)
+		}
+		var cd CalendarData
+		if err := json.Unmarshal(raw, &cd); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", summary.File, err)
+		}
+		b.Calendars = append(b.Calendars, cd)
+	}
+	return b, nil
+}
+
+func readTarGz(filename string) (map[string][]byte, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	files := make(map[string][]byte)
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		files[path.Clean(hdr.Name)] = data
+	}
+	return files, nil
+}
+
+func readZip(filename string) (map[string][]byte, error) {
+	zr, err := zip.OpenReader(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	files := make(map[string][]byte)
+	for _, zf := range zr.File {
+		if zf.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := zf.Open()
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		files[path.Clean(zf.Name)] = data
+	}
+	return files, nil
+}
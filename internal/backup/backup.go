@@ -0,0 +1,205 @@
+// Package backup builds and reads full calendar archives: every calendar's
+// metadata and events, serialized losslessly as calendar.Calendar and
+// calendar.Event JSON, plus a manifest with counts and time ranges.
+package backup
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+// Version is the archive layout version written to the manifest. Read
+// rejects archives with a newer version.
+const Version = 1
+
+// Manifest summarizes an archive. It is stored as manifest.json.
+type Manifest struct {
+	Version   int               `json:"version"`
+	CreatedAt time.Time         `json:"created_at"`
+	From      time.Time         `json:"from"`
+	To        time.Time         `json:"to"`
+	Events    int               `json:"events"`
+	Calendars []CalendarSummary `json:"calendars"`
+}
+
+// CalendarSummary describes one calendar in the manifest.
+type CalendarSummary struct {
+	ID       string     `json:"id"`
+	Title    string     `json:"title"`
+	Source   string     `json:"source"`
+	ReadOnly bool       `json:"read_only"`
+	File     string     `json:"file"`
+	Events   int        `json:"events"`
+	First    *time.Time `json:"first,omitempty"`
+	Last     *time.Time `json:"last,omitempty"`
+}
+
+// CalendarData is the content of one calendar file in the archive. Series
+// holds the first occurrence of each recurring event in Events, which may
+// fall before the archive's range; restore recreates a series from it so
+// that COUNT and UNTIL limits still end where they did.
+type CalendarData struct {
+	Calendar calendar.Calendar `json:"calendar"`
+	Events   []calendar.Event  `json:"events"`
+	Series   []calendar.Event  `json:"series,omitempty"`
+}
+
+// Backup is an archive in memory.
+type Backup struct {
+	Manifest  Manifest
+	Calendars []CalendarData
+}
+
+// New groups events by calendar and builds the manifest. Every calendar is
+// included, even those without events in the range, so a restore recreates
+// empty calendars too. Events whose calendar is not in calendars (which
+// should not happen) get a calendar entry built from the event.
+func New(calendars []calendar.Calendar, events []calendar.Event, from, to time.Time) *Backup {
+	index := make(map[string]int, len(calendars))
+	data := make([]CalendarData, 0, len(calendars))
+	for _, c := range calendars {
+		index[c.ID] = len(data)
+		data = append(data, CalendarData{Calendar: c, Events: []calendar.Event{}})
+	}
+	for _, e := range events {
+		i, ok := index[e.CalendarID]
+		if !ok {
+			i = len(data)
+			index[e.CalendarID] = i
+			data = append(data, CalendarData{
+				Calendar: calendar.Calendar{ID: e.CalendarID, Title: e.Calendar},
+				Events:   []calendar.Event{},
+			})
+		}
+		data[i].Events = append(data[i].Events, e)
+	}
+
+	b := &Backup{
+		Manifest: Manifest{
+			Version:   Version,
+			CreatedAt: time.Now(),
+			From:      from,
+			To:        to,
+		},
+		Calendars: data,
+	}
+	for i := range b.Calendars {
+		cd := &b.Calendars[i]
+		sort.SliceStable(cd.Events, func(a, b int) bool {
+			return cd.Events[a].StartDate.Before(cd.Events[b].StartDate)
+		})
+		summary := CalendarSummary{
+			ID:       cd.Calendar.ID,
+			Title:    cd.Calendar.Title,
+			Source:   cd.Calendar.Source,
+			ReadOnly: cd.Calendar.ReadOnly,
+			File:     calendarFile(i, cd.Calendar),
+			Events:   len(cd.Events),
+		}
+		if n := len(cd.Events); n > 0 {
+			first, last := cd.Events[0].StartDate, cd.Events[n-1].StartDate
+			summary.First, summary.Last = &first, &last
+		}
+		b.Manifest.Events += summary.Events
+		b.Manifest.Calendars = append(b.Manifest.Calendars, summary)
+	}
+	return b
+}
+
+// RecurringIDs lists the IDs of the recurring events in the archive, once
+// each, in archive order.
+func (b *Backup) RecurringIDs() []string {
+	seen := make(map[string]bool)
+	var ids []string
+	for _, cd := range b.Calendars {
+		for _, e := range cd.Events {
+			if e.Recurring && e.ID != "" && !seen[e.ID] {
+				seen[e.ID] = true
+				ids = append(ids, e.ID)
+			}
+		}
+	}
+	return ids
+}
+
+// AddSeries stores the first occurrence of a recurring series with the
+// calendar holding its occurrences. Events that are not part of a series
+// in the archive are ignored.
+func (b *Backup) AddSeries(first calendar.Event) {
+	for i := range b.Calendars {
+		cd := &b.Calendars[i]
+		for _, e := range cd.Events {
+			if e.Recurring && e.ID == first.ID {
+				cd.Series = append(cd.Series, first)
+				return
+			}
+		}
+	}
+}
+
+// calendarFile names a calendar's file inside the archive. The index keeps
+// names unique; the title keeps them readable when browsing the archive.
+func calendarFile(i int, c calendar.Calendar) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(c.Title) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case b.Len() > 0 && !strings.HasSuffix(b.String(), "-"):
+			b.WriteByte('-')
+		}
+	}
+	slug := strings.TrimSuffix(b.String(), "-")
+	if slug == "" {
+		slug = "calendar"
+	}
+	return fmt.Sprintf("calendars/%02d-%s.json", i+1, slug)
+}
+
+// EventInputs converts a calendar's events into inputs for recreating them
+// in the named calendar. A recurring series appears in the archive once
+// per occurrence, all sharing the event ID; it is recreated once, from its
+// first occurrence in Series so the recurrence rules count from the real
+// start of the series. Archives without it fall back to the earliest
+// occurrence in range. Attendees are left out because adding them would
+// send invitations again.
+func EventInputs(cd CalendarData, calendarName string) []calendar.CreateEventInput {
+	series := make(map[string]calendar.Event, len(cd.Series))
+	for _, s := range cd.Series {
+		series[s.ID] = s
+	}
+	seen := make(map[string]bool)
+	var inputs []calendar.CreateEventInput
+	for _, e := range cd.Events {
+		if e.Recurring && e.ID != "" {
+			if seen[e.ID] {
+				continue
+			}
+			seen[e.ID] = true
+			if s, ok := series[e.ID]; ok {
+				e = s
+			}
+		}
+		inputs = append(inputs, calendar.CreateEventInput{
+			Title:                 e.Title,
+			StartDate:             e.StartDate,
+			EndDate:               e.EndDate,
+			AllDay:                e.AllDay,
+			Location:              e.Location,
+			Notes:                 e.Notes,
+			URL:                   e.URL,
+			Calendar:              calendarName,
+			Alerts:                e.Alerts,
+			SuppressDefaultAlarms: true,
+			TimeZone:              e.TimeZone,
+			RecurrenceRules:       e.RecurrenceRules,
+			StructuredLocation:    e.StructuredLocation,
+			TravelTime:            e.TravelTime,
+		})
+	}
+	return inputs
+}
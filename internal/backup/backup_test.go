@@ -0,0 +1,171 @@
+package backup
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit"
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+func sampleBackup() *Backup {
+	calendars := []calendar.Calendar{
+		{ID: "cal-1", Title: "Work", Color: "#FF6961", Source: "iCloud"},
+		{ID: "cal-2", Title: "Family Events", Color: "#1BADF8", Source: "iCloud"},
+		{ID: "cal-3", Title: "Empty", Source: "iCloud"},
+	}
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	events := []calendar.Event{
+		{ID: "s1", Title: "Standup", CalendarID: "cal-1", Calendar: "Work", Recurring: true,
+			StartDate: start.AddDate(0, 0, 1), EndDate: start.AddDate(0, 0, 1).Add(15 * time.Minute),
+			RecurrenceRules: []eventkit.RecurrenceRule{eventkit.Daily(1)}},
+		{ID: "s1", Title: "Standup", CalendarID: "cal-1", Calendar: "Work", Recurring: true,
+			StartDate: start, EndDate: start.Add(15 * time.Minute),
+			RecurrenceRules: []eventkit.RecurrenceRule{eventkit.Daily(1)},
+			Alerts:          []calendar.Alert{{RelativeOffset: -5 * time.Minute}}},
+		{ID: "d1", Title: "Dinner", CalendarID: "cal-2", Calendar: "Family Events",
+			StartDate: start.Add(10 * time.Hour), EndDate: start.Add(12 * time.Hour),
+			Attendees: []calendar.Attendee{{Name: "Alice", Email: "alice@example.com"}}},
+	}
+	return New(calendars, events, start.AddDate(0, -1, 0), start.AddDate(0, 1, 0))
+}
+
+func TestNew(t *testing.T) {
+	b := sampleBackup()
+	if b.Manifest.Events != 3 {
+		t.Errorf("events: got %d", b.Manifest.Events)
+	}
+	if len(b.Manifest.Calendars) != 3 {
+		t.Fatalf("calendars: got %d", len(b.Manifest.Calendars))
+	}
+	work := b.Manifest.Calendars[0]
+	if work.File != "calendars/01-work.json" || work.Events != 2 {
+		t.Errorf("work summary: %+v", work)
+	}
+	if work.First == nil || !work.First.Before(*work.Last) {
+		t.Errorf("work range: %v - %v", work.First, work.Last)
+	}
+	if b.Manifest.Calendars[1].File != "calendars/02-family-events.json" {
+		t.Errorf("file: got %q", b.Manifest.Calendars[1].File)
+	}
+	empty := b.Manifest.Calendars[2]
+	if empty.Events != 0 || empty.First != nil {
+		t.Errorf("empty summary: %+v", empty)
+	}
+	// Events are sorted by start within a calendar.
+	if !b.Calendars[0].Events[0].StartDate.Before(b.Calendars[0].Events[1].StartDate) {
+		t.Error("events not sorted by start")
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	for _, name := range []string{"backup.tar.gz", "backup.tgz", "backup.zip"} {
+		t.Run(name, func(t *testing.T) {
+			b := sampleBackup()
+			path := filepath.Join(t.TempDir(), name)
+			if err := Write(b, path); err != nil {
+				t.Fatalf("write: %v", err)
+			}
+			got, err := Read(path)
+			if err != nil {
+				t.Fatalf("read: %v", err)
+			}
+			if got.Manifest.Events != 3 || len(got.Calendars) != 3 {
+				t.Fatalf("manifest: %+v", got.Manifest)
+			}
+			if got.Calendars[1].Calendar != b.Calendars[1].Calendar {
+				t.Errorf("calendar: got %+v", got.Calendars[1].Calendar)
+			}
+			standup := got.Calendars[0].Events[0]
+			if !standup.StartDate.Equal(b.Calendars[0].Events[0].StartDate) {
+				t.Errorf("start: got %v", standup.StartDate)
+			}
+			if len(standup.Alerts) != 1 || standup.Alerts[0].RelativeOffset != -5*time.Minute {
+				t.Errorf("alerts: got %+v", standup.Alerts)
+			}
+			if len(standup.RecurrenceRules) != 1 || standup.RecurrenceRules[0].Frequency != eventkit.FrequencyDaily {
+				t.Errorf("recurrence: got %+v", standup.RecurrenceRules)
+			}
+			if len(got.Calendars[1].Events[0].Attendees) != 1 {
+				t.Errorf("attendees not preserved: %+v", got.Calendars[1].Events[0])
+			}
+		})
+	}
+}
+
+func TestWrite_UnsupportedExtension(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "backup.rar")
+	if err := Write(sampleBackup(), path); err == nil {
+		t.Error("expected error for unsupported extension")
+	}
+}
+
+func TestEventInputs(t *testing.T) {
+	b := sampleBackup()
+
+	inputs := EventInputs(b.Calendars[0], "Work (restored)")
+	if len(inputs) != 1 {
+		t.Fatalf("expected recurring series once, got %d inputs", len(inputs))
+	}
+	in := inputs[0]
+	if in.Calendar != "Work (restored)" {
+		t.Errorf("calendar: got %q", in.Calendar)
+	}
+	if !in.StartDate.Equal(b.Calendars[0].Events[0].StartDate) {
+		t.Errorf("series should start at the earliest occurrence, got %v", in.StartDate)
+	}
+	if !in.SuppressDefaultAlarms || len(in.Alerts) != 1 {
+		t.Errorf("alerts: suppress=%v alerts=%+v", in.SuppressDefaultAlarms, in.Alerts)
+	}
+
+	dinner := EventInputs(b.Calendars[1], "Family Events")
+	if len(dinner) != 1 || len(dinner[0].Attendees) != 0 {
+		t.Errorf("attendees should not be restored: %+v", dinner)
+	}
+}
+
+func TestEventInputs_SeriesStart(t *testing.T) {
+	b := sampleBackup()
+	if got := b.RecurringIDs(); len(got) != 1 || got[0] != "s1" {
+		t.Fatalf("recurring IDs: got %v", got)
+	}
+
+	// A ten-day series that started a week before the archive's first
+	// occurrence: recreating it from that occurrence would run ten more
+	// days.
+	rule := eventkit.Daily(1).Count(10)
+	seriesStart := time.Date(2026, 2, 23, 9, 0, 0, 0, time.UTC)
+	for i := range b.Calendars[0].Events {
+		b.Calendars[0].Events[i].RecurrenceRules = []eventkit.RecurrenceRule{rule}
+	}
+	b.AddSeries(calendar.Event{ID: "s1", Title: "Standup", CalendarID: "cal-1", Recurring: true,
+		StartDate: seriesStart, EndDate: seriesStart.Add(15 * time.Minute),
+		RecurrenceRules: []eventkit.RecurrenceRule{rule}})
+	b.AddSeries(calendar.Event{ID: "d1", Title: "Dinner"})
+
+	if len(b.Calendars[0].Series) != 1 || len(b.Calendars[1].Series) != 0 {
+		t.Fatalf("series: %+v / %+v", b.Calendars[0].Series, b.Calendars[1].Series)
+	}
+
+	path := filepath.Join(t.TempDir(), "backup.zip")
+	if err := Write(b, path); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	got, err := Read(path)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+
+	inputs := EventInputs(got.Calendars[0], "Work")
+	if len(inputs) != 1 {
+		t.Fatalf("expected recurring series once, got %d inputs", len(inputs))
+	}
+	in := inputs[0]
+	if !in.StartDate.Equal(seriesStart) || !in.EndDate.Equal(seriesStart.Add(15*time.Minute)) {
+		t.Errorf("series should start at its first occurrence, got %v - %v", in.StartDate, in.EndDate)
+	}
+	if len(in.RecurrenceRules) != 1 || in.RecurrenceRules[0].End == nil || in.RecurrenceRules[0].End.OccurrenceCount != 10 {
+		t.Errorf("recurrence: got %+v", in.RecurrenceRules)
+	}
+}
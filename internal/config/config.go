@@ -0,0 +1,127 @@
+// Package config reads ical's user configuration file.
+//
+// The file lives at $XDG_CONFIG_HOME/ical/config (default
+// ~/.config/ical/config) and holds simple "key = value" lines. Blank lines
+// and lines starting with # are ignored. A "[section]" header prefixes the
+// keys below it, so these two are equivalent:
+//
+//	fields.report = title,start,organizer,self_status
+//
+//	[fields]
+//	report = title,start,organizer,self_status
+package config
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Config holds the key/value pairs from the config file.
+type Config struct {
+	values map[string]string
+}
+
+// Path returns the location of the config file.
+func Path() string {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, _ := os.UserHomeDir()
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "ical", "config")
+}
+
+// Load reads the config file. A missing file yields an empty Config.
+func Load() (*Config, error) {
+	f, err := os.Open(Path())
+	if errors.Is(err, os.ErrNotExist) {
+		return &Config{values: map[string]string{}}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open config: %w", err)
+	}
+	defer f.Close()
+
+	c, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", Path(), err)
+	}
+	return c, nil
+}
+
+// Parse reads config lines from r.
+func Parse(r io.Reader) (*Config, error) {
+	c := &Config{values: map[string]string{}}
+	section := ""
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNo)
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		if key == "" {
+			return nil, fmt.Errorf("line %d: missing key", lineNo)
+		}
+		if section != "" {
+			key = strings.ToLower(section) + "." + key
+		}
+		c.values[key] = unquote(strings.TrimSpace(value))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Get returns the value for key and whether it was set.
+func (c *Config) Get(key string) (string, bool) {
+	v, ok := c.values[strings.ToLower(key)]
+	return v, ok
+}
+
+// Section returns every key under prefix (without the "prefix." part),
+// e.g. Section("fields") for the field presets.
+func (c *Config) Section(prefix string) map[string]string {
+	prefix = strings.ToLower(prefix) + "."
+	out := map[string]string{}
+	for k, v := range c.values {
+		if name, ok := strings.CutPrefix(k, prefix); ok {
+			out[name] = v
+		}
+	}
+	return out
+}
+
+// Keys returns all keys in sorted order.
+func (c *Config) Keys() []string {
+	keys := make([]string, 0, len(c.values))
+	for k := range c.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' && s[len(s)-1] == '"' || s[0] == '\'' && s[len(s)-1] == '\'') {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	input := `# ical config
+fields.compact = date,time,title
+
+[fields]
+report = "title,start,organizer,self_status"
+
+[Display]
+Week-Start = monday
+`
+	c, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"fields.compact", "date,time,title"},
+		{"fields.report", "title,start,organizer,self_status"},
+		{"display.week-start", "monday"},
+		{"FIELDS.REPORT", "title,start,organizer,self_status"},
+	}
+	for _, tt := range tests {
+		got, ok := c.Get(tt.key)
+		if !ok || got != tt.want {
+			t.Errorf("Get(%q) = %q, %v; want %q", tt.key, got, ok, tt.want)
+		}
+	}
+
+	fields := c.Section("fields")
+	if len(fields) != 2 || fields["report"] == "" || fields["compact"] == "" {
+		t.Errorf("Section(fields) = %v", fields)
+	}
+	if _, ok := c.Get("missing"); ok {
+		t.Error("Get(missing) reported a value")
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, input := range []string{"no equals sign", " = value"} {
+		if _, err := Parse(strings.NewReader(input)); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", input)
+		}
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	c, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(c.Keys()) != 0 {
+		t.Errorf("keys = %v, want none", c.Keys())
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	if err := os.MkdirAll(filepath.Join(dir, "ical"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "ical", "config"), []byte("fields.mine = title\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if v, _ := c.Get("fields.mine"); v != "title" {
+		t.Errorf("fields.mine = %q", v)
+	}
+}
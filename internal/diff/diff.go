@@ -0,0 +1,193 @@
+// Package diff compares two sets of calendar events, such as an export
+// and the live calendar, and reports what was added, removed and changed.
+package diff
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+// Result is the difference between an old and a new set of events.
+type Result struct {
+	Added     []calendar.Event
+	Removed   []calendar.Event
+	Modified  []Modified
+	Unchanged int
+}
+
+// Modified is an event present in both sets whose fields differ.
+type Modified struct {
+	Old     calendar.Event
+	New     calendar.Event
+	Changes []Change
+}
+
+// Change is one changed field. Field is one of title, time, all_day,
+// calendar, location, notes, url or attendee. A time change holds each
+// span as an RFC 3339 "start/end" interval. An attendee change is one
+// attendee added (only New set) or removed (only Old set).
+type Change struct {
+	Field string `json:"field"`
+	Old   string `json:"old,omitempty"`
+	New   string `json:"new,omitempty"`
+}
+
+// Empty reports whether the two sets were the same.
+func (r *Result) Empty() bool {
+	return len(r.Added) == 0 && len(r.Removed) == 0 && len(r.Modified) == 0
+}
+
+// Compare matches the events of before and after and reports the differences.
+// Events are matched by ID and start first, so each occurrence of a
+// recurring series pairs with itself; then by ID alone, in start order,
+// which catches moved events; and last by title and start, for events
+// whose ID is missing or changed.
+func Compare(before, after []calendar.Event) *Result {
+	old, cur := sortedByStart(before), sortedByStart(after)
+	oldMatched := make([]bool, len(old))
+	newMatched := make([]bool, len(cur))
+	var pairs [][2]int
+
+	pass := func(key func(calendar.Event) string) {
+		buckets := make(map[string][]int)
+		for i, e := range old {
+			if k := key(e); !oldMatched[i] && k != "" {
+				buckets[k] = append(buckets[k], i)
+			}
+		}
+		for j, e := range cur {
+			k := key(e)
+			if newMatched[j] || k == "" || len(buckets[k]) == 0 {
+				continue
+			}
+			i := buckets[k][0]
+			buckets[k] = buckets[k][1:]
+			oldMatched[i], newMatched[j] = true, true
+			pairs = append(pairs, [2]int{i, j})
+		}
+	}
+	pass(func(e calendar.Event) string {
+		if e.ID == "" {
+			return ""
+		}
+		return e.ID + "\x00" + strconv.FormatInt(e.StartDate.Unix(), 10)
+	})
+	pass(func(e calendar.Event) string { return e.ID })
+	pass(func(e calendar.Event) string {
+		return strings.ToLower(strings.TrimSpace(e.Title)) + "\x00" + strconv.FormatInt(e.StartDate.Unix(), 10)
+	})
+
+	r := &Result{}
+	for i, e := range old {
+		if !oldMatched[i] {
+			r.Removed = append(r.Removed, e)
+		}
+	}
+	for j, e := range cur {
+		if !newMatched[j] {
+			r.Added = append(r.Added, e)
+		}
+	}
+	for _, p := range pairs {
+		o, n := old[p[0]], cur[p[1]]
+		if changes := compareEvents(o, n); len(changes) > 0 {
+			r.Modified = append(r.Modified, Modified{Old: o, New: n, Changes: changes})
+		} else {
+			r.Unchanged++
+		}
+	}
+	sort.SliceStable(r.Modified, func(i, j int) bool {
+		return r.Modified[i].New.StartDate.Before(r.Modified[j].New.StartDate)
+	})
+	return r
+}
+
+// compareEvents lists the fields that differ between two matched events.
+func compareEvents(o, n calendar.Event) []Change {
+	var changes []Change
+	field := func(name, from, to string) {
+		if from != to {
+			changes = append(changes, Change{Field: name, Old: from, New: to})
+		}
+	}
+
+	field("title", o.Title, n.Title)
+	if !sameSpan(o, n) {
+		field("time", span(o), span(n))
+	}
+	field("all_day", strconv.FormatBool(o.AllDay), strconv.FormatBool(n.AllDay))
+	field("calendar", o.Calendar, n.Calendar)
+	field("location", o.Location, n.Location)
+	field("notes", strings.TrimSpace(o.Notes), strings.TrimSpace(n.Notes))
+	field("url", o.URL, n.URL)
+
+	oldAttendees, newAttendees := attendeeSet(o.Attendees), attendeeSet(n.Attendees)
+	for _, a := range n.Attendees {
+		if _, ok := oldAttendees[attendeeKey(a)]; !ok {
+			changes = append(changes, Change{Field: "attendee", New: attendeeLabel(a)})
+		}
+	}
+	for _, a := range o.Attendees {
+		if _, ok := newAttendees[attendeeKey(a)]; !ok {
+			changes = append(changes, Change{Field: "attendee", Old: attendeeLabel(a)})
+		}
+	}
+	return changes
+}
+
+// sameSpan compares start and end times, or only the dates for all-day
+// events, whose midnight depends on the zone they were exported in.
+func sameSpan(o, n calendar.Event) bool {
+	if o.AllDay && n.AllDay {
+		return sameDate(o.StartDate, n.StartDate) && sameDate(o.EndDate, n.EndDate)
+	}
+	return o.StartDate.Equal(n.StartDate) && o.EndDate.Equal(n.EndDate)
+}
+
+func sameDate(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+func span(e calendar.Event) string {
+	return e.StartDate.Format(time.RFC3339) + "/" + e.EndDate.Format(time.RFC3339)
+}
+
+func attendeeKey(a calendar.Attendee) string {
+	if a.Email != "" {
+		return strings.ToLower(a.Email)
+	}
+	return strings.ToLower(a.Name)
+}
+
+func attendeeSet(attendees []calendar.Attendee) map[string]struct{} {
+	set := make(map[string]struct{}, len(attendees))
+	for _, a := range attendees {
+		set[attendeeKey(a)] = struct{}{}
+	}
+	return set
+}
+
+// attendeeLabel names an attendee as "Name <email>", or whichever of the
+// two is known.
+func attendeeLabel(a calendar.Attendee) string {
+	switch {
+	case a.Name != "" && a.Email != "":
+		return a.Name + " <" + a.Email + ">"
+	case a.Email != "":
+		return a.Email
+	default:
+		return a.Name
+	}
+}
+
+func sortedByStart(events []calendar.Event) []calendar.Event {
+	out := append([]calendar.Event(nil), events...)
+	sort.SliceStable(out, func(i, j int) bool { return out[i].StartDate.Before(out[j].StartDate) })
+	return out
+}
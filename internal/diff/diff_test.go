@@ -0,0 +1,102 @@
+package diff
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+func at(day, hour int) time.Time {
+	return time.Date(2026, 3, day, hour, 0, 0, 0, time.UTC)
+}
+
+func event(id, title string, day, hour int) calendar.Event {
+	return calendar.Event{ID: id, Title: title, Calendar: "Team", StartDate: at(day, hour), EndDate: at(day, hour+1)}
+}
+
+func TestCompare(t *testing.T) {
+	ana := calendar.Attendee{Name: "Ana", Email: "ana@example.com"}
+	bob := calendar.Attendee{Name: "Bob", Email: "bob@example.com"}
+
+	standup := event("s", "Standup", 2, 9)
+	standup.Attendees = []calendar.Attendee{ana}
+	moved := event("m", "Review", 3, 14)
+	renamed := event("r", "Sync", 4, 10)
+	gone := event("g", "Offsite prep", 5, 11)
+	noID := event("", "Lunch", 6, 12)
+
+	before := []calendar.Event{standup, moved, renamed, gone, noID}
+
+	standupAfter := standup
+	standupAfter.Attendees = []calendar.Attendee{{Name: "Ana", Email: "ANA@example.com"}, bob}
+	movedAfter := moved
+	movedAfter.StartDate, movedAfter.EndDate = at(3, 16), at(3, 17)
+	movedAfter.Location = "Room 2"
+	renamedAfter := renamed
+	renamedAfter.Title = "Weekly sync"
+	added := event("n", "Retro", 6, 15)
+
+	after := []calendar.Event{added, noID, renamedAfter, movedAfter, standupAfter}
+
+	r := Compare(before, after)
+
+	if len(r.Added) != 1 || r.Added[0].ID != "n" {
+		t.Errorf("added = %v, want Retro", r.Added)
+	}
+	if len(r.Removed) != 1 || r.Removed[0].ID != "g" {
+		t.Errorf("removed = %v, want Offsite prep", r.Removed)
+	}
+	if r.Unchanged != 1 {
+		t.Errorf("unchanged = %d, want 1", r.Unchanged)
+	}
+
+	want := map[string][]Change{
+		"s": {{Field: "attendee", New: "Bob <bob@example.com>"}},
+		"m": {
+			{Field: "time", Old: "2026-03-03T14:00:00Z/2026-03-03T15:00:00Z", New: "2026-03-03T16:00:00Z/2026-03-03T17:00:00Z"},
+			{Field: "location", New: "Room 2"},
+		},
+		"r": {{Field: "title", Old: "Sync", New: "Weekly sync"}},
+	}
+	if len(r.Modified) != len(want) {
+		t.Fatalf("modified = %v, want %d events", r.Modified, len(want))
+	}
+	for i, m := range r.Modified {
+		if !reflect.DeepEqual(m.Changes, want[m.New.ID]) {
+			t.Errorf("changes for %s = %v, want %v", m.New.Title, m.Changes, want[m.New.ID])
+		}
+		if i > 0 && m.New.StartDate.Before(r.Modified[i-1].New.StartDate) {
+			t.Errorf("modified not in start order")
+		}
+	}
+}
+
+func TestCompareRecurring(t *testing.T) {
+	// Occurrences of a series share an ID; each pairs with itself, and the
+	// one that moved pairs with what is left.
+	before := []calendar.Event{event("s", "Standup", 2, 9), event("s", "Standup", 3, 9), event("s", "Standup", 4, 9)}
+	after := []calendar.Event{event("s", "Standup", 2, 9), event("s", "Standup", 3, 11), event("s", "Standup", 4, 9)}
+
+	r := Compare(before, after)
+	if len(r.Added) != 0 || len(r.Removed) != 0 || r.Unchanged != 2 {
+		t.Fatalf("added %d, removed %d, unchanged %d; want 0, 0, 2", len(r.Added), len(r.Removed), r.Unchanged)
+	}
+	if len(r.Modified) != 1 || r.Modified[0].Changes[0].Field != "time" || !r.Modified[0].Old.StartDate.Equal(at(3, 9)) {
+		t.Errorf("modified = %v, want the 3 Mar occurrence moved", r.Modified)
+	}
+}
+
+func TestCompareAllDay(t *testing.T) {
+	// The same all-day event exported from different zones.
+	ist := time.FixedZone("IST", 5*3600+1800)
+	before := calendar.Event{ID: "h", Title: "Holiday", AllDay: true,
+		StartDate: time.Date(2026, 3, 10, 0, 0, 0, 0, ist), EndDate: time.Date(2026, 3, 11, 0, 0, 0, 0, ist)}
+	after := before
+	after.StartDate, after.EndDate = time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)
+
+	if r := Compare([]calendar.Event{before}, []calendar.Event{after}); !r.Empty() {
+		t.Errorf("all-day event reported as changed: %v", r.Modified)
+	}
+}
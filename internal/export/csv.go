@@ -11,7 +11,8 @@ import (
 )
 
 // CSV exports events as CSV.
-func CSV(events []calendar.Event, w io.Writer) error {
+func CSV(events []calendar.Event, w io.Writer, opts ...WriteOption) error {
+	o := newWriteOptions(opts)
 	writer := csv.NewWriter(w)
 	defer writer.Flush()
 
@@ -24,8 +25,8 @@ func CSV(events []calendar.Event, w io.Writer) error {
 		record := []string{
 			e.ID,
 			e.Title,
-			e.StartDate.Format(time.RFC3339),
-			e.EndDate.Format(time.RFC3339),
+			o.in(e.StartDate, e.AllDay).Format(time.RFC3339),
+			o.in(e.EndDate, e.AllDay).Format(time.RFC3339),
 			strconv.FormatBool(e.AllDay),
 			e.Calendar,
 			e.Location,
@@ -44,15 +45,21 @@ func CSV(events []calendar.Event, w io.Writer) error {
 }
 
 // ParseCSV reads a CSV file and returns CreateEventInput slice.
-func ParseCSV(r io.Reader) ([]calendar.CreateEventInput, error) {
+func ParseCSV(r io.Reader, opts ...ParseOption) ([]calendar.CreateEventInput, error) {
+	inputs, _, err := parseCSV(r, newParseOptions(opts))
+	return inputs, err
+}
+
+// parseCSV is ParseCSV that also returns each row's ID column.
+func parseCSV(r io.Reader, o parseOptions) ([]calendar.CreateEventInput, []string, error) {
 	reader := csv.NewReader(r)
 	records, err := reader.ReadAll()
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse CSV: %w", err)
+		return nil, nil, fmt.Errorf("failed to parse CSV: %w", err)
 	}
 
 	if len(records) < 2 {
-		return nil, fmt.Errorf("CSV file has no data rows")
+		return nil, nil, fmt.Errorf("CSV file has no data rows")
 	}
 
 	// Find column indices from header
@@ -63,6 +70,7 @@ func ParseCSV(r io.Reader) ([]calendar.CreateEventInput, error) {
 	}
 
 	var inputs []calendar.CreateEventInput
+	var ids []string
 	for i, record := range records[1:] {
 		title := getCol(record, cols, "Title")
 		if title == "" {
@@ -72,19 +80,33 @@ func ParseCSV(r io.Reader) ([]calendar.CreateEventInput, error) {
 		startStr := getCol(record, cols, "Start")
 		endStr := getCol(record, cols, "End")
 		if startStr == "" || endStr == "" {
-			return nil, fmt.Errorf("row %d: missing Start or End", i+2)
+			return nil, nil, fmt.Errorf("row %d: missing Start or End", i+2)
+		}
+
+		// Timestamps without an offset are floating: read them in the
+		// row's Timezone column when it names a zone, else in the
+		// configured zone.
+		tz := getCol(record, cols, "Timezone")
+		loc := o.loc
+		if tz != "" {
+			if l, err := time.LoadLocation(tz); err == nil {
+				loc = l
+			}
 		}
 
-		startTime, err := time.Parse(time.RFC3339, startStr)
+		startTime, startFloating, err := parseCSVTime(startStr, loc)
 		if err != nil {
-			return nil, fmt.Errorf("row %d: invalid Start %q: %w", i+2, startStr, err)
+			return nil, nil, fmt.Errorf("row %d: invalid Start %q: %w", i+2, startStr, err)
 		}
-		endTime, err := time.Parse(time.RFC3339, endStr)
+		endTime, _, err := parseCSVTime(endStr, loc)
 		if err != nil {
-			return nil, fmt.Errorf("row %d: invalid End %q: %w", i+2, endStr, err)
+			return nil, nil, fmt.Errorf("row %d: invalid End %q: %w", i+2, endStr, err)
 		}
 
 		allDay, _ := strconv.ParseBool(getCol(record, cols, "AllDay"))
+		if startFloating && tz == "" && !allDay {
+			tz = o.floatingZone()
+		}
 
 		inputs = append(inputs, calendar.CreateEventInput{
 			Title:     title,
@@ -95,11 +117,37 @@ func ParseCSV(r io.Reader) ([]calendar.CreateEventInput, error) {
 			Location:  getCol(record, cols, "Location"),
 			Notes:     getCol(record, cols, "Notes"),
 			URL:       getCol(record, cols, "URL"),
-			TimeZone:  getCol(record, cols, "Timezone"),
+			TimeZone:  tz,
 		})
+		ids = append(ids, getCol(record, cols, "ID"))
 	}
 
-	return inputs, nil
+	return inputs, ids, nil
+}
+
+// csvFloatingLayouts are the offset-less timestamp forms accepted besides
+// RFC 3339, as written by spreadsheets and most schedule exports.
+var csvFloatingLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// parseCSVTime parses an RFC 3339 timestamp, or a floating one in loc. The
+// second result reports whether the value was floating.
+func parseCSVTime(s string, loc *time.Location) (time.Time, bool, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, false, nil
+	}
+	for _, layout := range csvFloatingLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, true, nil
+		}
+	}
+	_, err := time.Parse(time.RFC3339, s)
+	return time.Time{}, false, err
 }
 
 func getCol(record []string, cols map[string]int, name string) string {
@@ -141,6 +141,81 @@ func TestCSV_Export(t *testing.T) {
 	}
 }
 
+func TestCSV_DisplayZone(t *testing.T) {
+	london, err1 := time.LoadLocation("Europe/London")
+	la, err2 := time.LoadLocation("America/Los_Angeles")
+	if err1 != nil || err2 != nil {
+		t.Skip("no tzdata")
+	}
+	defer func(l *time.Location) { time.Local = l }(time.Local)
+	time.Local = london
+
+	events := []calendar.Event{
+		{Title: "Standup", StartDate: time.Date(2026, 3, 17, 9, 0, 0, 0, time.UTC), EndDate: time.Date(2026, 3, 17, 9, 30, 0, 0, time.UTC)},
+		{Title: "Holiday", AllDay: true, StartDate: time.Date(2026, 3, 17, 0, 0, 0, 0, london), EndDate: time.Date(2026, 3, 18, 0, 0, 0, 0, london)},
+	}
+	var buf bytes.Buffer
+	if err := CSV(events, &buf, WithDisplayZone(la)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read CSV: %v", err)
+	}
+	if got, want := records[1][2], "2026-03-17T02:00:00-07:00"; got != want {
+		t.Errorf("timed start = %q, want %q", got, want)
+	}
+	if got, want := records[2][2], "2026-03-17T00:00:00-07:00"; got != want {
+		t.Errorf("all-day start = %q, want %q (same date)", got, want)
+	}
+}
+
+func TestWrite_DisplayZone(t *testing.T) {
+	london, err1 := time.LoadLocation("Europe/London")
+	tokyo, err2 := time.LoadLocation("Asia/Tokyo")
+	if err1 != nil || err2 != nil {
+		t.Skip("no tzdata")
+	}
+	defer func(l *time.Location) { time.Local = l }(time.Local)
+	time.Local = london
+
+	events := []calendar.Event{
+		{Title: "Holiday", AllDay: true, StartDate: time.Date(2026, 3, 31, 0, 0, 0, 0, london), EndDate: time.Date(2026, 4, 1, 0, 0, 0, 0, london)},
+		{ID: "s1", Title: "Standup", StartDate: time.Date(2026, 3, 31, 20, 0, 0, 0, time.UTC), EndDate: time.Date(2026, 3, 31, 20, 30, 0, 0, time.UTC)},
+	}
+	zone := WithDisplayZone(tokyo)
+
+	var buf bytes.Buffer
+	if err := Write("org", events, nil, &buf, zone); err != nil {
+		t.Fatalf("org: %v", err)
+	}
+	for _, want := range []string{"  <2026-03-31 Tue>\n", "  <2026-04-01 Wed 05:00-05:30>\n"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("org missing %q in:\n%s", want, buf.String())
+		}
+	}
+
+	buf.Reset()
+	if err := Write("markdown", events, nil, &buf, zone); err != nil {
+		t.Fatalf("markdown: %v", err)
+	}
+	for _, want := range []string{"## Tuesday, 31 March 2026\n\n- **All day** Holiday\n", "## Wednesday, 01 April 2026\n\n- **05:00–05:30** Standup\n"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("markdown missing %q in:\n%s", want, buf.String())
+		}
+	}
+
+	groups := Split(events, SplitMonth, "json", zone)
+	if len(groups) != 2 || groups[0].Key != "2026-03" || groups[0].Events[0].Title != "Holiday" || groups[1].Key != "2026-04" {
+		t.Errorf("month groups: %+v", groups)
+	}
+	events[1].ID = ""
+	groups = Split(events[1:], SplitEvent, "json", zone)
+	if len(groups) != 1 || groups[0].File != "standup-20260401T0500.json" {
+		t.Errorf("event groups: %+v", groups)
+	}
+}
+
 func TestCSV_Roundtrip(t *testing.T) {
 	var buf bytes.Buffer
 	events := sampleEvents()
@@ -992,3 +1067,783 @@ END:VCALENDAR
 		t.Errorf("start hour: got %d", inputs[0].StartDate.Hour())
 	}
 }
+
+func TestOrg_Export(t *testing.T) {
+	events := []calendar.Event{
+		{
+			ID:        "event-1",
+			Title:     "Team Standup",
+			StartDate: time.Date(2026, 3, 15, 14, 0, 0, 0, time.Local),
+			EndDate:   time.Date(2026, 3, 15, 15, 0, 0, 0, time.Local),
+			Calendar:  "Work",
+			Location:  "Room A",
+			Notes:     "Agenda\n* not a heading",
+		},
+		{
+			ID:        "event-2",
+			Title:     "Offsite",
+			StartDate: time.Date(2026, 3, 16, 0, 0, 0, 0, time.Local),
+			EndDate:   time.Date(2026, 3, 18, 0, 0, 0, 0, time.Local),
+			AllDay:    true,
+			Calendar:  "Work",
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Org(events, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := buf.String()
+
+	checks := []string{
+		"* Team Standup\n",
+		"<2026-03-15 Sun 14:00-15:00>",
+		":LOCATION: Room A",
+		":CALENDAR: Work",
+		":ID: event-1",
+		"  Agenda\n",
+		"  ,* not a heading\n",
+		"* Offsite\n",
+		"<2026-03-16 Mon>--<2026-03-17 Tue>",
+	}
+	for _, want := range checks {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestOrgTimestamp(t *testing.T) {
+	tests := []struct {
+		name  string
+		event calendar.Event
+		want  string
+	}{
+		{
+			"single all-day",
+			calendar.Event{
+				StartDate: time.Date(2026, 3, 15, 0, 0, 0, 0, time.Local),
+				EndDate:   time.Date(2026, 3, 16, 0, 0, 0, 0, time.Local),
+				AllDay:    true,
+			},
+			"<2026-03-15 Sun>",
+		},
+		{
+			"crosses midnight",
+			calendar.Event{
+				StartDate: time.Date(2026, 3, 15, 22, 0, 0, 0, time.Local),
+				EndDate:   time.Date(2026, 3, 16, 1, 30, 0, 0, time.Local),
+			},
+			"<2026-03-15 Sun 22:00>--<2026-03-16 Mon 01:30>",
+		},
+		{
+			"weekly repeater",
+			calendar.Event{
+				StartDate:       time.Date(2026, 3, 15, 9, 0, 0, 0, time.Local),
+				EndDate:         time.Date(2026, 3, 15, 9, 30, 0, 0, time.Local),
+				RecurrenceRules: []eventkit.RecurrenceRule{eventkit.Weekly(1)},
+			},
+			"<2026-03-15 Sun 09:00-09:30 +1w>",
+		},
+		{
+			"constrained rule has no repeater",
+			calendar.Event{
+				StartDate:       time.Date(2026, 3, 15, 9, 0, 0, 0, time.Local),
+				EndDate:         time.Date(2026, 3, 15, 9, 30, 0, 0, time.Local),
+				RecurrenceRules: []eventkit.RecurrenceRule{eventkit.Weekly(1, eventkit.Monday, eventkit.Friday)},
+			},
+			"<2026-03-15 Sun 09:00-09:30>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := orgTimestamp(tt.event, newWriteOptions(nil)); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseOrg(t *testing.T) {
+	input := `#+TITLE: Agenda
+
+* Planning
+** TODO [#A] Team Standup <2026-03-15 Sun 14:00-15:00 +1w>   :work:
+  :PROPERTIES:
+  :LOCATION: Room A
+  :CALENDAR: Work
+  :URL:      https://example.com/standup
+  :END:
+  Agenda
+  ,* not a heading
+** Offsite
+  <2026-03-16 Mon>--<2026-03-17 Tue>
+** Dentist
+   SCHEDULED: <2026-03-20 Fri 09:30 .+1m> DEADLINE: <2026-03-21 Sat>
+** Someday idea
+   No timestamp, so not an event.
+`
+	inputs, err := ParseOrg(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(inputs) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(inputs))
+	}
+
+	standup := inputs[0]
+	if standup.Title != "Team Standup" {
+		t.Errorf("title: got %q", standup.Title)
+	}
+	if !standup.StartDate.Equal(time.Date(2026, 3, 15, 14, 0, 0, 0, time.Local)) {
+		t.Errorf("start: got %v", standup.StartDate)
+	}
+	if !standup.EndDate.Equal(time.Date(2026, 3, 15, 15, 0, 0, 0, time.Local)) {
+		t.Errorf("end: got %v", standup.EndDate)
+	}
+	if standup.Location != "Room A" || standup.Calendar != "Work" || standup.URL != "https://example.com/standup" {
+		t.Errorf("properties: got location=%q calendar=%q url=%q", standup.Location, standup.Calendar, standup.URL)
+	}
+	if standup.Notes != "Agenda\n* not a heading" {
+		t.Errorf("notes: got %q", standup.Notes)
+	}
+	if len(standup.RecurrenceRules) != 1 || standup.RecurrenceRules[0].Frequency != eventkit.FrequencyWeekly || standup.RecurrenceRules[0].Interval != 1 {
+		t.Errorf("recurrence: got %+v", standup.RecurrenceRules)
+	}
+
+	offsite := inputs[1]
+	if !offsite.AllDay {
+		t.Error("offsite should be all-day")
+	}
+	if !offsite.EndDate.Equal(time.Date(2026, 3, 18, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("offsite end: got %v", offsite.EndDate)
+	}
+
+	dentist := inputs[2]
+	if !dentist.StartDate.Equal(time.Date(2026, 3, 20, 9, 30, 0, 0, time.Local)) {
+		t.Errorf("dentist start: got %v", dentist.StartDate)
+	}
+	if dentist.EndDate.Sub(dentist.StartDate) != time.Hour {
+		t.Errorf("dentist duration: got %v", dentist.EndDate.Sub(dentist.StartDate))
+	}
+	if len(dentist.RecurrenceRules) != 1 || dentist.RecurrenceRules[0].Frequency != eventkit.FrequencyMonthly {
+		t.Errorf("dentist recurrence: got %+v", dentist.RecurrenceRules)
+	}
+}
+
+func TestParseOrg_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"hourly repeater", "* Ping <2026-03-15 Sun 14:00 +2h>\n"},
+		{"end before start", "* Backwards <2026-03-15 Sun 15:00-14:00>\n"},
+		{"hour 24", "* Late <2026-03-15 Sun 23:00-24:00>\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseOrg(strings.NewReader(tt.input)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestOrg_RoundTrip(t *testing.T) {
+	events := []calendar.Event{
+		{
+			Title:           "Review",
+			StartDate:       time.Date(2026, 3, 15, 9, 0, 0, 0, time.Local),
+			EndDate:         time.Date(2026, 3, 15, 9, 45, 0, 0, time.Local),
+			Calendar:        "Work",
+			Location:        "Room B",
+			Notes:           "Bring notes",
+			RecurrenceRules: []eventkit.RecurrenceRule{eventkit.Daily(2)},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Org(events, &buf); err != nil {
+		t.Fatalf("export error: %v", err)
+	}
+	inputs, err := ParseOrg(&buf)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if len(inputs) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(inputs))
+	}
+	got := inputs[0]
+	if got.Title != "Review" || got.Calendar != "Work" || got.Location != "Room B" || got.Notes != "Bring notes" {
+		t.Errorf("round trip mismatch: %+v", got)
+	}
+	if !got.StartDate.Equal(events[0].StartDate) || !got.EndDate.Equal(events[0].EndDate) {
+		t.Errorf("times: got %v - %v", got.StartDate, got.EndDate)
+	}
+	if len(got.RecurrenceRules) != 1 || got.RecurrenceRules[0].Interval != 2 {
+		t.Errorf("recurrence: got %+v", got.RecurrenceRules)
+	}
+}
+
+func TestOrg_RoundTripZeroLength(t *testing.T) {
+	at := time.Date(2026, 3, 15, 14, 0, 0, 0, time.Local)
+	events := []calendar.Event{
+		{Title: "Deadline", StartDate: at, EndDate: at},
+		{Title: "Review", StartDate: at.Add(time.Hour), EndDate: at.Add(2 * time.Hour)},
+	}
+
+	var buf bytes.Buffer
+	if err := Org(events, &buf); err != nil {
+		t.Fatalf("export error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "<2026-03-15 Sun 14:00-14:00>") {
+		t.Errorf("timestamp:\n%s", buf.String())
+	}
+	inputs, err := ParseOrg(&buf)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if len(inputs) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(inputs))
+	}
+	if !inputs[0].StartDate.Equal(at) || !inputs[0].EndDate.Equal(at) {
+		t.Errorf("zero-length event: got %v - %v", inputs[0].StartDate, inputs[0].EndDate)
+	}
+}
+
+func TestOrg_RoundTripTitles(t *testing.T) {
+	titles := []string{
+		"TODO list review",
+		"DONE",
+		"[#A] priorities",
+		"Sync :team:",
+		"TODO sync :team:ops:",
+		`\TODO escaped`,
+		`Sync \:team:`,
+		":solo:",
+		"Plain title",
+	}
+	at := time.Date(2026, 3, 15, 9, 0, 0, 0, time.Local)
+	var events []calendar.Event
+	for i, title := range titles {
+		start := at.AddDate(0, 0, i)
+		events = append(events, calendar.Event{Title: title, StartDate: start, EndDate: start.Add(time.Hour)})
+	}
+
+	var buf bytes.Buffer
+	if err := Org(events, &buf); err != nil {
+		t.Fatalf("export error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "* \\TODO list review\n") {
+		t.Errorf("keyword not escaped:\n%s", buf.String())
+	}
+	inputs, err := ParseOrg(&buf)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if len(inputs) != len(titles) {
+		t.Fatalf("expected %d events, got %d", len(titles), len(inputs))
+	}
+	for i, want := range titles {
+		if inputs[i].Title != want {
+			t.Errorf("title = %q, want %q", inputs[i].Title, want)
+		}
+	}
+}
+
+func TestOrg_RoundTripIndentedNotes(t *testing.T) {
+	notes := "Agenda:\n  - slides\n    * backup deck\n  :PROPERTIES:\n,*literal\n\n    code block"
+	events := []calendar.Event{{
+		Title:     "Review",
+		StartDate: time.Date(2026, 3, 15, 9, 0, 0, 0, time.Local),
+		EndDate:   time.Date(2026, 3, 15, 10, 0, 0, 0, time.Local),
+		Notes:     notes,
+	}}
+
+	var buf bytes.Buffer
+	if err := Org(events, &buf); err != nil {
+		t.Fatalf("export error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "\n      ,* backup deck\n    ,:PROPERTIES:\n  ,,*literal\n") {
+		t.Errorf("escaping:\n%s", buf.String())
+	}
+	inputs, err := ParseOrg(&buf)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if len(inputs) != 1 || inputs[0].Notes != notes {
+		t.Errorf("notes = %q, want %q", inputs[0].Notes, notes)
+	}
+
+	// Hand-written bodies with no shared indent keep their nesting.
+	org := "* Sync <2026-03-16 Mon 10:00-11:00>\nTopics:\n  - one\n    - two\n"
+	inputs, err = ParseOrg(strings.NewReader(org))
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if want := "Topics:\n  - one\n    - two"; inputs[0].Notes != want {
+		t.Errorf("notes = %q, want %q", inputs[0].Notes, want)
+	}
+}
+
+func TestMarkdown_Export(t *testing.T) {
+	events := []calendar.Event{
+		{
+			Title:         "Planning [draft]",
+			StartDate:     time.Date(2026, 3, 16, 10, 0, 0, 0, time.Local),
+			EndDate:       time.Date(2026, 3, 16, 11, 0, 0, 0, time.Local),
+			Calendar:      "Work",
+			ConferenceURL: "https://meet.example.com/abc",
+		},
+		{
+			Title:     "Standup",
+			StartDate: time.Date(2026, 3, 15, 9, 0, 0, 0, time.Local),
+			EndDate:   time.Date(2026, 3, 15, 9, 15, 0, 0, time.Local),
+			Calendar:  "Work",
+			Location:  "Room_1",
+		},
+		{
+			Title:     "Holiday",
+			StartDate: time.Date(2026, 3, 16, 0, 0, 0, 0, time.Local),
+			EndDate:   time.Date(2026, 3, 17, 0, 0, 0, 0, time.Local),
+			AllDay:    true,
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Markdown(events, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `## Sunday, 15 March 2026
+
+- **09:00–09:15** Standup _(Work)_ · Room\_1
+
+## Monday, 16 March 2026
+
+- **All day** Holiday
+- **10:00–11:00** Planning \[draft\] _(Work)_ · [Join](https://meet.example.com/abc)
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestMarkdown_ExportEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Markdown(nil, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no output, got %q", buf.String())
+	}
+}
+
+func TestSplit(t *testing.T) {
+	events := []calendar.Event{
+		{ID: "A1:B2", Title: "Standup", Calendar: "Work", StartDate: time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local)},
+		{ID: "C3", Title: "Dentist", Calendar: "Personal", StartDate: time.Date(2026, 4, 1, 9, 0, 0, 0, time.Local)},
+		{ID: "D4", Title: "Review", Calendar: "Work", StartDate: time.Date(2026, 4, 3, 9, 0, 0, 0, time.Local)},
+		{ID: "E5", Title: "Sync", Calendar: "work", StartDate: time.Date(2026, 4, 4, 9, 0, 0, 0, time.Local)},
+	}
+
+	tests := []struct {
+		name   string
+		mode   SplitMode
+		files  []string
+		counts []int
+	}{
+		{"calendar", SplitCalendar, []string{"personal.ics", "work.ics", "work-2.ics"}, []int{1, 2, 1}},
+		{"month", SplitMonth, []string{"2026-03.ics", "2026-04.ics"}, []int{1, 3}},
+		{"event", SplitEvent, []string{"A1_B2.ics", "C3.ics", "D4.ics", "E5.ics"}, []int{1, 1, 1, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups := Split(events, tt.mode, "ics")
+			if len(groups) != len(tt.files) {
+				t.Fatalf("expected %d groups, got %d", len(tt.files), len(groups))
+			}
+			for i, g := range groups {
+				if g.File != tt.files[i] {
+					t.Errorf("group %d file: got %q, want %q", i, g.File, tt.files[i])
+				}
+				if len(g.Events) != tt.counts[i] {
+					t.Errorf("group %d events: got %d, want %d", i, len(g.Events), tt.counts[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSplitReservesManifest(t *testing.T) {
+	events := []calendar.Event{
+		{ID: "1", Calendar: "Index", StartDate: time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local)},
+		{ID: "index", Calendar: "Work", StartDate: time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local)},
+	}
+	if g := Split(events, SplitCalendar, "json"); g[0].File != "index-2.json" {
+		t.Errorf("calendar %q file = %q, want index-2.json", g[0].Key, g[0].File)
+	}
+	if g := Split(events, SplitEvent, "json"); g[1].File != "index-2.json" {
+		t.Errorf("event %q file = %q, want index-2.json", g[1].Key, g[1].File)
+	}
+	// Other formats can't clash with the JSON manifest.
+	if g := Split(events, SplitCalendar, "ics"); g[0].File != "index.ics" {
+		t.Errorf("ics file = %q, want index.ics", g[0].File)
+	}
+}
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Work", "work"},
+		{"Work / Team", "work-team"},
+		{"  Family  Events!", "family-events"},
+		{"Café", "café"},
+		{"", "untitled"},
+		{"???", "untitled"},
+	}
+	for _, tt := range tests {
+		if got := slugify(tt.in); got != tt.want {
+			t.Errorf("slugify(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseSplitMode(t *testing.T) {
+	if m, err := ParseSplitMode("Month"); err != nil || m != SplitMonth {
+		t.Errorf("got %q, %v", m, err)
+	}
+	if _, err := ParseSplitMode("week"); err == nil {
+		t.Error("expected error for unknown mode")
+	}
+}
+
+func TestNewManifest(t *testing.T) {
+	events := []calendar.Event{
+		{ID: "1", Calendar: "Work", StartDate: time.Date(2026, 3, 5, 9, 0, 0, 0, time.UTC)},
+		{ID: "2", Calendar: "Work", StartDate: time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)},
+	}
+	groups := Split(events, SplitCalendar, "json")
+	m := NewManifest(groups, SplitCalendar, "json", time.Time{}, time.Time{})
+	if m.Events != 2 || len(m.Files) != 1 {
+		t.Fatalf("unexpected manifest: %+v", m)
+	}
+	entry := m.Files[0]
+	if entry.File != "work.json" || entry.Key != "Work" {
+		t.Errorf("entry: %+v", entry)
+	}
+	if !entry.First.Equal(events[1].StartDate) || !entry.Last.Equal(events[0].StartDate) {
+		t.Errorf("range: %v - %v", entry.First, entry.Last)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteManifest(m, &buf); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"split": "calendar"`) {
+		t.Errorf("manifest JSON missing split mode:\n%s", buf.String())
+	}
+}
+
+func TestICSWithCalendars(t *testing.T) {
+	calendars := []calendar.Calendar{
+		{ID: "cal-1", Title: "Work", Color: "#FF6961", Source: "iCloud"},
+		{ID: "cal-2", Title: "Family", Color: "#1BADF8", Source: "Google: me@example.com"},
+	}
+	events := []calendar.Event{
+		{ID: "e1", Title: "Standup", Calendar: "Work", CalendarID: "cal-1",
+			StartDate: time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC), EndDate: time.Date(2026, 3, 15, 9, 30, 0, 0, time.UTC)},
+		{ID: "e2", Title: "Dinner", Calendar: "Family", CalendarID: "cal-2",
+			StartDate: time.Date(2026, 3, 15, 19, 0, 0, 0, time.UTC), EndDate: time.Date(2026, 3, 15, 21, 0, 0, 0, time.UTC)},
+	}
+
+	t.Run("multiple calendars", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := ICSWithCalendars(events, calendars, &buf); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		out := buf.String()
+		if strings.Contains(out, "X-WR-CALNAME") {
+			t.Error("X-WR-CALNAME should only be written for single-calendar exports")
+		}
+		for _, want := range []string{
+			"X-ICAL-CALENDAR;X-ICAL-COLOR=#FF6961;X-ICAL-SOURCE=iCloud:Work",
+			`X-ICAL-CALENDAR;X-ICAL-COLOR=#1BADF8;X-ICAL-SOURCE="Google: me@example.com":Family`,
+		} {
+			if !strings.Contains(out, want) {
+				t.Errorf("output missing %q:\n%s", want, out)
+			}
+		}
+
+		inputs, cals, err := ParseICSCalendars(&buf)
+		if err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if inputs[0].Calendar != "Work" || inputs[1].Calendar != "Family" {
+			t.Errorf("routing: got %q, %q", inputs[0].Calendar, inputs[1].Calendar)
+		}
+		if len(cals) != 2 {
+			t.Fatalf("expected 2 calendars, got %+v", cals)
+		}
+		want := calendar.Calendar{Title: "Family", Color: "#1BADF8", Source: "Google: me@example.com"}
+		if cals[1] != want {
+			t.Errorf("got %+v, want %+v", cals[1], want)
+		}
+	})
+
+	t.Run("single calendar", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := ICSWithCalendars(events[:1], calendars, &buf); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		out := buf.String()
+		for _, want := range []string{"X-WR-CALNAME:Work\n", "X-APPLE-CALENDAR-COLOR:#FF6961\n"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("output missing %q:\n%s", want, out)
+			}
+		}
+	})
+}
+
+func TestParseICSCalendars_WRCalName(t *testing.T) {
+	ics := `BEGIN:VCALENDAR
+VERSION:2.0
+X-WR-CALNAME:Team Calendar
+X-APPLE-CALENDAR-COLOR:#34C759
+BEGIN:VEVENT
+SUMMARY:Planning
+DTSTART:20260315T090000Z
+DTEND:20260315T100000Z
+END:VEVENT
+END:VCALENDAR`
+
+	inputs, cals, err := ParseICSCalendars(strings.NewReader(ics))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(inputs) != 1 || inputs[0].Calendar != "Team Calendar" {
+		t.Errorf("calendar: got %+v", inputs)
+	}
+	if len(cals) != 1 || cals[0].Color != "#34C759" {
+		t.Errorf("calendars: got %+v", cals)
+	}
+}
+
+func TestSplitICSParams(t *testing.T) {
+	name, params := splitICSParams(`X-ICAL-CALENDAR;X-ICAL-SOURCE="a;b:c";X-ICAL-COLOR=#FFF`)
+	if name != "X-ICAL-CALENDAR" {
+		t.Errorf("name: got %q", name)
+	}
+	if params["X-ICAL-SOURCE"] != "a;b:c" || params["X-ICAL-COLOR"] != "#FFF" {
+		t.Errorf("params: got %v", params)
+	}
+}
+
+func TestParseICS_TimeZones(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+	ics := `BEGIN:VCALENDAR
+BEGIN:VEVENT
+SUMMARY:Floating
+DTSTART:20260315T090000
+DTEND:20260315T100000
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Berlin
+DTSTART;TZID=Europe/Berlin:20260315T090000
+DTEND;TZID=Europe/Berlin:20260315T100000
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Outlook
+DTSTART;TZID="W. Europe Standard Time":20260315T090000
+DTEND;TZID="W. Europe Standard Time":20260315T100000
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:UTC
+DTSTART:20260315T090000Z
+DTEND:20260315T100000Z
+END:VEVENT
+END:VCALENDAR`
+
+	tests := []struct {
+		name     string
+		policy   FloatingPolicy
+		floating string
+	}{
+		{"local", FloatingLocal, "America/New_York"},
+		{"utc", FloatingUTC, "UTC"},
+		{"keep", FloatingKeep, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inputs, err := ParseICS(strings.NewReader(ics), WithTimeZone(ny), WithFloating(tt.policy))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(inputs) != 4 {
+				t.Fatalf("expected 4 events, got %d", len(inputs))
+			}
+
+			floating := inputs[0]
+			if !floating.StartDate.Equal(time.Date(2026, 3, 15, 9, 0, 0, 0, ny)) {
+				t.Errorf("floating start: got %v", floating.StartDate)
+			}
+			if floating.TimeZone != tt.floating {
+				t.Errorf("floating zone: got %q, want %q", floating.TimeZone, tt.floating)
+			}
+
+			berlin := inputs[1]
+			if berlin.TimeZone != "Europe/Berlin" || berlin.StartDate.UTC().Hour() != 8 {
+				t.Errorf("TZID event: zone=%q start=%v", berlin.TimeZone, berlin.StartDate.UTC())
+			}
+
+			// Unknown TZID names fall back to the floating handling.
+			outlook := inputs[2]
+			if !outlook.StartDate.Equal(floating.StartDate) || outlook.TimeZone != tt.floating {
+				t.Errorf("unknown TZID: zone=%q start=%v", outlook.TimeZone, outlook.StartDate)
+			}
+
+			utc := inputs[3]
+			if utc.TimeZone != "" || utc.StartDate.Hour() != 9 {
+				t.Errorf("UTC event: zone=%q start=%v", utc.TimeZone, utc.StartDate)
+			}
+		})
+	}
+}
+
+func TestParseCSV_FloatingTimes(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+	data := `Title,Start,End,AllDay,Timezone
+Keynote,2026-03-15 09:00,2026-03-15 10:00,false,
+Workshop,2026-03-15T13:00:00,2026-03-15T15:00:00,false,Europe/Berlin
+Dinner,2026-03-15T19:00:00-04:00,2026-03-15T21:00:00-04:00,false,
+`
+	inputs, err := ParseCSV(strings.NewReader(data), WithTimeZone(ny))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(inputs) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(inputs))
+	}
+	if !inputs[0].StartDate.Equal(time.Date(2026, 3, 15, 9, 0, 0, 0, ny)) || inputs[0].TimeZone != "America/New_York" {
+		t.Errorf("keynote: start=%v zone=%q", inputs[0].StartDate, inputs[0].TimeZone)
+	}
+	if inputs[1].TimeZone != "Europe/Berlin" || inputs[1].StartDate.UTC().Hour() != 12 {
+		t.Errorf("workshop: start=%v zone=%q", inputs[1].StartDate.UTC(), inputs[1].TimeZone)
+	}
+	if inputs[2].TimeZone != "" || inputs[2].StartDate.UTC().Hour() != 23 {
+		t.Errorf("dinner: start=%v zone=%q", inputs[2].StartDate.UTC(), inputs[2].TimeZone)
+	}
+
+	if _, err := ParseCSV(strings.NewReader("Title,Start,End\nX,tomorrow,later\n")); err == nil {
+		t.Error("expected error for unparseable timestamp")
+	}
+}
+
+func TestParseOrg_TimeZone(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+	inputs, err := ParseOrg(strings.NewReader("* Talk <2026-03-15 Sun 14:00-15:00>\n* Holiday <2026-03-16 Mon>\n"), WithTimeZone(tokyo))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !inputs[0].StartDate.Equal(time.Date(2026, 3, 15, 14, 0, 0, 0, tokyo)) || inputs[0].TimeZone != "Asia/Tokyo" {
+		t.Errorf("talk: start=%v zone=%q", inputs[0].StartDate, inputs[0].TimeZone)
+	}
+	if inputs[1].TimeZone != "" {
+		t.Errorf("all-day events should not get a zone, got %q", inputs[1].TimeZone)
+	}
+}
+
+func TestParseFloatingPolicy(t *testing.T) {
+	for _, s := range []string{"local", "UTC", " keep "} {
+		if _, err := ParseFloatingPolicy(s); err != nil {
+			t.Errorf("%q: unexpected error %v", s, err)
+		}
+	}
+	if _, err := ParseFloatingPolicy("float"); err == nil {
+		t.Error("expected error for unknown policy")
+	}
+}
+
+func TestReadSnapshot(t *testing.T) {
+	events := sampleEvents()
+	events[0].Attendees = []calendar.Attendee{
+		{Name: "Ana, PM", Email: "ana@example.com", Status: calendar.ParticipantStatusAccepted},
+		{Name: "Bob", Status: calendar.ParticipantStatusPending},
+	}
+
+	for _, format := range []string{"json", "csv", "ics"} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Write(format, events, nil, &buf); err != nil {
+				t.Fatalf("export: %v", err)
+			}
+			got, err := ReadSnapshot(format, &buf)
+			if err != nil {
+				t.Fatalf("ReadSnapshot: %v", err)
+			}
+			if len(got) != 2 {
+				t.Fatalf("got %d events, want 2", len(got))
+			}
+			if got[0].ID != "event-1" || got[1].ID != "event-2" {
+				t.Errorf("IDs = %q, %q", got[0].ID, got[1].ID)
+			}
+			if got[0].Title != "Team Standup" || got[0].Location != "Room A" || !got[0].StartDate.Equal(events[0].StartDate) {
+				t.Errorf("event = %+v", got[0])
+			}
+			if got[0].Calendar != "Work" {
+				t.Errorf("calendar = %q, want Work", got[0].Calendar)
+			}
+
+			if format == "csv" {
+				if got[0].Attendees != nil {
+					t.Errorf("CSV attendees = %v, want none", got[0].Attendees)
+				}
+				return
+			}
+			want := []calendar.Attendee{
+				{Name: "Ana, PM", Email: "ana@example.com", Status: calendar.ParticipantStatusAccepted},
+				{Name: "Bob", Status: calendar.ParticipantStatusPending},
+			}
+			if len(got[0].Attendees) != len(want) {
+				t.Fatalf("attendees = %v, want %v", got[0].Attendees, want)
+			}
+			for i := range want {
+				if got[0].Attendees[i] != want[i] {
+					t.Errorf("attendee %d = %+v, want %+v", i, got[0].Attendees[i], want[i])
+				}
+			}
+		})
+	}
+
+	if _, err := ReadSnapshot("org", strings.NewReader("")); err == nil {
+		t.Error("expected an error for org")
+	}
+}
+
+func TestICSAttendee(t *testing.T) {
+	tests := []struct {
+		in   calendar.Attendee
+		want string
+	}{
+		{calendar.Attendee{Name: "Ana", Email: "ana@example.com", Status: calendar.ParticipantStatusDeclined}, "ATTENDEE;CN=Ana;PARTSTAT=DECLINED:mailto:ana@example.com"},
+		{calendar.Attendee{Name: "Doe; Jane", Email: "jane@example.com"}, `ATTENDEE;CN="Doe; Jane":mailto:jane@example.com`},
+		{calendar.Attendee{Name: "Bob"}, "ATTENDEE;CN=Bob:invalid:nomail"},
+	}
+	for _, tt := range tests {
+		if got := icsAttendee(tt.in); got != tt.want {
+			t.Errorf("icsAttendee(%+v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package export
+
+import (
+	"io"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+// Write exports events in the named format. Unknown formats fall back to
+// JSON, matching the export command's default. calendars is only used by
+// ICS, to record calendar color and account; it may be nil. opts apply to
+// CSV, Org and Markdown.
+func Write(format string, events []calendar.Event, calendars []calendar.Calendar, w io.Writer, opts ...WriteOption) error {
+	switch format {
+	case "csv":
+		return CSV(events, w, opts...)
+	case "ics":
+		return ICSWithCalendars(events, calendars, w)
+	case "org":
+		return Org(events, w, opts...)
+	case "markdown", "md":
+		return Markdown(events, w, opts...)
+	default:
+		return JSON(events, w)
+	}
+}
+
+// Extension returns the file extension (without the dot) used for files
+// written in the named format.
+func Extension(format string) string {
+	switch format {
+	case "csv", "ics", "org":
+		return format
+	case "markdown", "md":
+		return "md"
+	default:
+		return "json"
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"bufio"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -14,11 +16,34 @@ import (
 
 // ICS exports events in iCalendar format (RFC 5545).
 func ICS(events []calendar.Event, w io.Writer) error {
+	return ICSWithCalendars(events, nil, w)
+}
+
+// ICSWithCalendars exports events like ICS and records the calendar each
+// event belongs to in an X-ICAL-CALENDAR property, with the calendar's color
+// and account taken from calendars, so that an import can route events back
+// and recreate missing calendars. When every event comes from one calendar,
+// the file also gets the calendar-level X-WR-CALNAME and
+// X-APPLE-CALENDAR-COLOR properties that Calendar.app and Google Calendar
+// read when importing or subscribing.
+func ICSWithCalendars(events []calendar.Event, calendars []calendar.Calendar, w io.Writer) error {
+	lookup := newCalendarLookup(calendars)
+
 	fmt.Fprintln(w, "BEGIN:VCALENDAR")
 	fmt.Fprintln(w, "VERSION:2.0")
 	fmt.Fprintln(w, "PRODID:-//ical CLI//EN")
 	fmt.Fprintln(w, "CALSCALE:GREGORIAN")
 
+	if name, ok := singleCalendar(events); ok {
+		fmt.Fprintf(w, "X-WR-CALNAME:%s\n", escapeICS(name))
+		if cal, ok := lookup.find(events[0]); ok && cal.Color != "" {
+			fmt.Fprintf(w, "X-APPLE-CALENDAR-COLOR:%s\n", cal.Color)
+		}
+	}
+	if tz := localZoneName(); tz != "" {
+		fmt.Fprintf(w, "X-WR-TIMEZONE:%s\n", tz)
+	}
+
 	for _, e := range events {
 		fmt.Fprintln(w, "BEGIN:VEVENT")
 		fmt.Fprintf(w, "UID:%s\n", e.ID)
@@ -33,6 +58,19 @@ func ICS(events []calendar.Event, w io.Writer) error {
 
 		fmt.Fprintf(w, "SUMMARY:%s\n", escapeICS(e.Title))
 
+		if e.Calendar != "" {
+			prop := "X-ICAL-CALENDAR"
+			if cal, ok := lookup.find(e); ok {
+				if cal.Color != "" {
+					prop += ";X-ICAL-COLOR=" + icsParamValue(cal.Color)
+				}
+				if cal.Source != "" {
+					prop += ";X-ICAL-SOURCE=" + icsParamValue(cal.Source)
+				}
+			}
+			fmt.Fprintf(w, "%s:%s\n", prop, escapeICS(e.Calendar))
+		}
+
 		if e.Location != "" {
 			fmt.Fprintf(w, "LOCATION:%s\n", escapeICS(e.Location))
 		}
@@ -43,6 +81,10 @@ func ICS(events []calendar.Event, w io.Writer) error {
 			fmt.Fprintf(w, "URL:%s\n", e.URL)
 		}
 
+		for _, a := range e.Attendees {
+			fmt.Fprintln(w, icsAttendee(a))
+		}
+
 		for _, rule := range e.RecurrenceRules {
 			fmt.Fprintf(w, "RRULE:%s\n", formatRRule(rule))
 		}
@@ -69,6 +111,101 @@ func ICS(events []calendar.Event, w io.Writer) error {
 	return nil
 }
 
+// calendarLookup finds an event's calendar by ID, falling back to the title
+// for events whose CalendarID is not set.
+type calendarLookup struct {
+	byID    map[string]calendar.Calendar
+	byTitle map[string]calendar.Calendar
+}
+
+func newCalendarLookup(calendars []calendar.Calendar) calendarLookup {
+	l := calendarLookup{
+		byID:    make(map[string]calendar.Calendar, len(calendars)),
+		byTitle: make(map[string]calendar.Calendar, len(calendars)),
+	}
+	for _, c := range calendars {
+		l.byID[c.ID] = c
+		l.byTitle[c.Title] = c
+	}
+	return l
+}
+
+func (l calendarLookup) find(e calendar.Event) (calendar.Calendar, bool) {
+	if c, ok := l.byID[e.CalendarID]; ok && e.CalendarID != "" {
+		return c, true
+	}
+	c, ok := l.byTitle[e.Calendar]
+	return c, ok
+}
+
+// singleCalendar reports the calendar name when all events share one.
+func singleCalendar(events []calendar.Event) (string, bool) {
+	if len(events) == 0 || events[0].Calendar == "" {
+		return "", false
+	}
+	for _, e := range events[1:] {
+		if e.Calendar != events[0].Calendar {
+			return "", false
+		}
+	}
+	return events[0].Calendar, true
+}
+
+// localZoneName returns the IANA name of the local time zone, or "" when it
+// cannot be determined. time.Local is named "Local" unless TZ is set, so
+// fall back to the /etc/localtime symlink that macOS and most Linux
+// distributions use.
+func localZoneName() string {
+	if name := time.Local.String(); name != "Local" && name != "" {
+		return name
+	}
+	target, err := os.Readlink("/etc/localtime")
+	if err != nil {
+		return ""
+	}
+	if _, zone, ok := strings.Cut(filepath.ToSlash(target), "zoneinfo/"); ok {
+		return zone
+	}
+	return ""
+}
+
+// icsParamValue quotes a property parameter value when it contains
+// characters that are delimiters in the content line (RFC 5545 §3.2).
+// Double quotes cannot be escaped inside a parameter, so they are dropped.
+func icsParamValue(s string) string {
+	s = strings.ReplaceAll(s, `"`, "")
+	if strings.ContainsAny(s, ":;,") {
+		return `"` + s + `"`
+	}
+	return s
+}
+
+// splitICSParams splits a property name with parameters, such as
+// `X-ICAL-CALENDAR;X-ICAL-SOURCE="a;b"`, into the name and its parameters.
+func splitICSParams(key string) (string, map[string]string) {
+	var parts []string
+	inQuote := false
+	start := 0
+	for i, r := range key {
+		switch {
+		case r == '"':
+			inQuote = !inQuote
+		case r == ';' && !inQuote:
+			parts = append(parts, key[start:i])
+			start = i + 1
+		}
+	}
+	parts = append(parts, key[start:])
+
+	params := make(map[string]string, len(parts)-1)
+	for _, p := range parts[1:] {
+		if k, v, ok := strings.Cut(p, "="); ok {
+			params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+		}
+	}
+	return strings.ToUpper(parts[0]), params
+}
+
 func escapeICS(s string) string {
 	s = strings.ReplaceAll(s, "\\", "\\\\")
 	s = strings.ReplaceAll(s, ";", "\\;")
@@ -138,13 +275,64 @@ func formatRRule(rule eventkit.RecurrenceRule) string {
 }
 
 // ParseICS reads an ICS (iCalendar RFC 5545) file and returns CreateEventInput slice.
-func ParseICS(r io.Reader) ([]calendar.CreateEventInput, error) {
+func ParseICS(r io.Reader, opts ...ParseOption) ([]calendar.CreateEventInput, error) {
+	inputs, _, err := ParseICSCalendars(r, opts...)
+	return inputs, err
+}
+
+// ParseICSCalendars reads an ICS file like ParseICS and also returns the
+// calendars it describes, in order of first appearance. Each event's
+// Calendar is set from X-ICAL-CALENDAR, or from the file-level X-WR-CALNAME
+// for files written by other apps. The returned calendars carry only the
+// Title, Color and Source recorded in the file.
+//
+// DTSTART/DTEND with a TZID are read in that zone, which becomes the
+// event's TimeZone. Floating times (no "Z", no usable TZID) are handled per
+// the ParseOptions.
+func ParseICSCalendars(r io.Reader, opts ...ParseOption) ([]calendar.CreateEventInput, []calendar.Calendar, error) {
+	o := newParseOptions(opts)
+	events, calendars := scanICS(r)
+
+	inputs := make([]calendar.CreateEventInput, 0, len(events))
+	for _, e := range events {
+		input, err := e.toInput(o)
+		if err != nil {
+			return nil, nil, err
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs, calendars, nil
+}
+
+// scanICS collects the VEVENTs of an ICS stream, each with its calendar
+// resolved, and the calendars the file describes.
+func scanICS(r io.Reader) ([]*icsEvent, []calendar.Calendar) {
 	lines := unfoldICS(r)
 
-	var inputs []calendar.CreateEventInput
+	var events []*icsEvent
 	var cur *icsEvent
 	inAlarm := false
 
+	var fileCal calendar.Calendar
+	var calendars []calendar.Calendar
+	seen := make(map[string]int)
+	addCalendar := func(c calendar.Calendar) {
+		if c.Title == "" {
+			return
+		}
+		if i, ok := seen[c.Title]; ok {
+			if calendars[i].Color == "" {
+				calendars[i].Color = c.Color
+			}
+			if calendars[i].Source == "" {
+				calendars[i].Source = c.Source
+			}
+			return
+		}
+		seen[c.Title] = len(calendars)
+		calendars = append(calendars, c)
+	}
+
 	for _, line := range lines {
 		switch {
 		case line == "BEGIN:VEVENT":
@@ -154,11 +342,11 @@ func ParseICS(r io.Reader) ([]calendar.CreateEventInput, error) {
 			if cur == nil {
 				continue
 			}
-			input, err := cur.toInput()
-			if err != nil {
-				return nil, err
+			if cur.calendar.Title == "" {
+				cur.calendar = fileCal
 			}
-			inputs = append(inputs, input)
+			addCalendar(cur.calendar)
+			events = append(events, cur)
 			cur = nil
 		case line == "BEGIN:VALARM":
 			inAlarm = true
@@ -166,6 +354,16 @@ func ParseICS(r io.Reader) ([]calendar.CreateEventInput, error) {
 			inAlarm = false
 		default:
 			if cur == nil {
+				// Calendar-level properties; X-WR-CALNAME normally precedes
+				// the first VEVENT.
+				if key, val, ok := splitICSLine(line); ok {
+					switch key {
+					case "X-WR-CALNAME":
+						fileCal.Title = unescapeICS(val)
+					case "X-APPLE-CALENDAR-COLOR":
+						fileCal.Color = val
+					}
+				}
 				continue
 			}
 			if inAlarm {
@@ -181,6 +379,8 @@ func ParseICS(r io.Reader) ([]calendar.CreateEventInput, error) {
 				continue
 			}
 			switch {
+			case key == "UID":
+				cur.uid = val
 			case key == "SUMMARY":
 				cur.title = unescapeICS(val)
 			case key == "LOCATION":
@@ -189,44 +389,66 @@ func ParseICS(r io.Reader) ([]calendar.CreateEventInput, error) {
 				cur.notes = unescapeICS(val)
 			case key == "URL":
 				cur.url = val
+			case key == "X-ICAL-CALENDAR" || strings.HasPrefix(key, "X-ICAL-CALENDAR;"):
+				_, params := splitICSParams(key)
+				cur.calendar = calendar.Calendar{
+					Title:  unescapeICS(val),
+					Color:  params["X-ICAL-COLOR"],
+					Source: params["X-ICAL-SOURCE"],
+				}
+			case key == "ATTENDEE" || strings.HasPrefix(key, "ATTENDEE;"):
+				cur.attendees = append(cur.attendees, parseICSAttendee(key, val))
 			case key == "RRULE":
 				if rule, err := parseRRule(val); err == nil {
 					cur.rrules = append(cur.rrules, rule)
 				}
 			case key == "DTSTART" || strings.HasPrefix(key, "DTSTART;"):
+				_, params := splitICSParams(key)
 				cur.dtstart = val
-				cur.dtstartAllDay = strings.Contains(key, "VALUE=DATE")
+				cur.dtstartAllDay = params["VALUE"] == "DATE"
+				cur.dtstartTZID = params["TZID"]
 			case key == "DTEND" || strings.HasPrefix(key, "DTEND;"):
+				_, params := splitICSParams(key)
 				cur.dtend = val
-				cur.dtendAllDay = strings.Contains(key, "VALUE=DATE")
+				cur.dtendAllDay = params["VALUE"] == "DATE"
+				cur.dtendTZID = params["TZID"]
 			}
 		}
 	}
 
-	return inputs, nil
+	// A file-level calendar with no events is still worth reporting so an
+	// import can recreate it.
+	addCalendar(fileCal)
+
+	return events, calendars
 }
 
 // icsEvent holds parsed VEVENT properties before conversion.
 type icsEvent struct {
+	uid          string
 	title        string
 	dtstart      string
 	dtend        string
 	dtstartAllDay bool
 	dtendAllDay  bool
+	dtstartTZID  string
+	dtendTZID    string
 	location     string
 	notes        string
 	url          string
 	rrules       []eventkit.RecurrenceRule
 	alerts       []time.Duration
+	calendar     calendar.Calendar
+	attendees    []calendar.Attendee
 }
 
-func (e *icsEvent) toInput() (calendar.CreateEventInput, error) {
+func (e *icsEvent) toInput(o parseOptions) (calendar.CreateEventInput, error) {
 	if e.title == "" {
 		return calendar.CreateEventInput{}, fmt.Errorf("VEVENT missing SUMMARY")
 	}
 
 	allDay := e.dtstartAllDay
-	start, err := parseICSDateTime(e.dtstart, allDay)
+	start, tz, err := parseICSEventTime(e.dtstart, allDay, e.dtstartTZID, o)
 	if err != nil {
 		return calendar.CreateEventInput{}, fmt.Errorf("invalid DTSTART %q: %w", e.dtstart, err)
 	}
@@ -234,7 +456,7 @@ func (e *icsEvent) toInput() (calendar.CreateEventInput, error) {
 	// DTEND is optional in ICS; default to start + 1 hour (or +1 day for all-day)
 	var end time.Time
 	if e.dtend != "" {
-		end, err = parseICSDateTime(e.dtend, e.dtendAllDay)
+		end, _, err = parseICSEventTime(e.dtend, e.dtendAllDay, e.dtendTZID, o)
 		if err != nil {
 			return calendar.CreateEventInput{}, fmt.Errorf("invalid DTEND %q: %w", e.dtend, err)
 		}
@@ -259,9 +481,70 @@ func (e *icsEvent) toInput() (calendar.CreateEventInput, error) {
 		URL:             e.url,
 		Alerts:          alerts,
 		RecurrenceRules: e.rrules,
+		Calendar:        e.calendar.Title,
+		TimeZone:        tz,
 	}, nil
 }
 
+// icsPartStat maps attendee statuses to and from ATTENDEE's PARTSTAT.
+var icsPartStat = map[calendar.ParticipantStatus]string{
+	calendar.ParticipantStatusPending:   "NEEDS-ACTION",
+	calendar.ParticipantStatusAccepted:  "ACCEPTED",
+	calendar.ParticipantStatusDeclined:  "DECLINED",
+	calendar.ParticipantStatusTentative: "TENTATIVE",
+}
+
+// icsAttendee renders an ATTENDEE line. Attendees without an email get a
+// placeholder address, since the value must be a URI.
+func icsAttendee(a calendar.Attendee) string {
+	prop := "ATTENDEE"
+	if a.Name != "" {
+		prop += ";CN=" + icsParamValue(a.Name)
+	}
+	if stat, ok := icsPartStat[a.Status]; ok {
+		prop += ";PARTSTAT=" + stat
+	}
+	addr := "invalid:nomail"
+	if a.Email != "" {
+		addr = "mailto:" + a.Email
+	}
+	return prop + ":" + addr
+}
+
+func parseICSAttendee(key, val string) calendar.Attendee {
+	_, params := splitICSParams(key)
+	a := calendar.Attendee{Name: params["CN"]}
+	if len(val) >= len("mailto:") && strings.EqualFold(val[:len("mailto:")], "mailto:") {
+		a.Email = val[len("mailto:"):]
+	}
+	for status, stat := range icsPartStat {
+		if strings.EqualFold(params["PARTSTAT"], stat) {
+			a.Status = status
+		}
+	}
+	return a
+}
+
+// parseICSEventTime parses a DTSTART/DTEND value and returns the TimeZone
+// to record for the event: the TZID when it names a zone Go knows, the
+// floating policy's zone for floating times, and "" for UTC and date-only
+// values. A TZID that cannot be loaded (such as a Windows zone name from
+// Outlook) is treated as floating.
+func parseICSEventTime(val string, dateOnly bool, tzid string, o parseOptions) (time.Time, string, error) {
+	if dateOnly || strings.HasSuffix(val, "Z") {
+		t, err := parseICSDateTime(val, dateOnly)
+		return t, "", err
+	}
+	if tzid != "" {
+		if loc, err := time.LoadLocation(tzid); err == nil {
+			t, err := time.ParseInLocation("20060102T150405", val, loc)
+			return t, tzid, err
+		}
+	}
+	t, err := time.ParseInLocation("20060102T150405", val, o.loc)
+	return t, o.floatingZone(), err
+}
+
 // unfoldICS reads an ICS stream and unfolds continuation lines (RFC 5545 §3.1).
 // Lines starting with a space or tab are appended to the previous line.
 func unfoldICS(r io.Reader) []string {
@@ -282,13 +565,19 @@ func unfoldICS(r io.Reader) []string {
 }
 
 // splitICSLine splits "KEY:VALUE" or "KEY;PARAMS:VALUE" returning the full key
-// (including params) and the value. Returns false if the line has no colon.
+// (including params) and the value. Colons inside quoted parameter values
+// do not end the key. Returns false if the line has no colon.
 func splitICSLine(line string) (string, string, bool) {
-	idx := strings.Index(line, ":")
-	if idx < 0 {
-		return "", "", false
+	inQuote := false
+	for i, r := range line {
+		switch {
+		case r == '"':
+			inQuote = !inQuote
+		case r == ':' && !inQuote:
+			return line[:i], line[i+1:], true
+		}
 	}
-	return line[:idx], line[idx+1:], true
+	return "", "", false
 }
 
 func unescapeICS(s string) string {
@@ -10,19 +10,27 @@ import (
 )
 
 type eventExport struct {
-	ID          string    `json:"id"`
-	Title       string    `json:"title"`
-	StartDate   time.Time `json:"start_date"`
-	EndDate     time.Time `json:"end_date"`
-	AllDay      bool      `json:"all_day"`
-	Calendar    string    `json:"calendar"`
-	CalendarID  string    `json:"calendar_id"`
-	Location    string    `json:"location,omitempty"`
-	Notes       string    `json:"notes,omitempty"`
-	URL         string    `json:"url,omitempty"`
-	Status      string    `json:"status"`
-	Recurring   bool      `json:"recurring"`
-	TimeZone    string    `json:"timezone,omitempty"`
+	ID         string           `json:"id"`
+	Title      string           `json:"title"`
+	StartDate  time.Time        `json:"start_date"`
+	EndDate    time.Time        `json:"end_date"`
+	AllDay     bool             `json:"all_day"`
+	Calendar   string           `json:"calendar"`
+	CalendarID string           `json:"calendar_id"`
+	Location   string           `json:"location,omitempty"`
+	Notes      string           `json:"notes,omitempty"`
+	URL        string           `json:"url,omitempty"`
+	Status     string           `json:"status"`
+	Recurring  bool             `json:"recurring"`
+	TimeZone   string           `json:"timezone,omitempty"`
+	Organizer  string           `json:"organizer,omitempty"`
+	Attendees  []attendeeExport `json:"attendees,omitempty"`
+}
+
+type attendeeExport struct {
+	Name   string `json:"name,omitempty"`
+	Email  string `json:"email,omitempty"`
+	Status string `json:"status"`
 }
 
 // JSON exports events as a JSON array.
@@ -43,6 +51,10 @@ func JSON(events []calendar.Event, w io.Writer) error {
 			Status:     e.Status.String(),
 			Recurring:  e.Recurring,
 			TimeZone:   e.TimeZone,
+			Organizer:  e.Organizer,
+		}
+		for _, a := range e.Attendees {
+			out[i].Attendees = append(out[i].Attendees, attendeeExport{Name: a.Name, Email: a.Email, Status: a.Status.String()})
 		}
 	}
 
@@ -0,0 +1,86 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+// Markdown exports events as a day-grouped agenda: one "##" heading per day
+// and a bullet per event, with a link to the conference call when there is
+// one. Meant for pasting into daily notes (Obsidian, Logseq, plain files).
+// Days and times are in the local zone, or the one given with
+// WithDisplayZone.
+func Markdown(events []calendar.Event, w io.Writer, opts ...WriteOption) error {
+	o := newWriteOptions(opts)
+	sorted := make([]calendar.Event, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].StartDate.Before(sorted[j].StartDate)
+	})
+
+	var day time.Time
+	for i, e := range sorted {
+		start := o.in(e.StartDate, e.AllDay)
+		if i == 0 || !sameDay(day, start) {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+			day = start
+			fmt.Fprintf(w, "## %s\n\n", start.Format("Monday, 02 January 2006"))
+		}
+		fmt.Fprintf(w, "- %s\n", markdownAgendaItem(e, o))
+	}
+	return nil
+}
+
+func markdownAgendaItem(e calendar.Event, o writeOptions) string {
+	start := o.in(e.StartDate, e.AllDay)
+	end := o.in(e.EndDate, e.AllDay)
+
+	var b strings.Builder
+	switch {
+	case e.AllDay:
+		b.WriteString("**All day**")
+	case sameDay(start, end):
+		fmt.Fprintf(&b, "**%s–%s**", start.Format("15:04"), end.Format("15:04"))
+	default:
+		fmt.Fprintf(&b, "**%s–%s**", start.Format("15:04"), end.Format("Jan 02 15:04"))
+	}
+
+	fmt.Fprintf(&b, " %s", escapeMarkdown(e.Title))
+	if e.Calendar != "" {
+		fmt.Fprintf(&b, " _(%s)_", escapeMarkdown(e.Calendar))
+	}
+	if e.Location != "" {
+		fmt.Fprintf(&b, " · %s", escapeMarkdown(e.Location))
+	}
+	if e.ConferenceURL != "" {
+		fmt.Fprintf(&b, " · [Join](%s)", e.ConferenceURL)
+	}
+	return b.String()
+}
+
+// markdownEscaper backslash-escapes the characters that would otherwise turn
+// a title into emphasis, a link or inline code.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"*", `\*`,
+	"_", `\_`,
+	"`", "\\`",
+	"[", `\[`,
+	"]", `\]`,
+	"<", `\<`,
+	">", `\>`,
+	"#", `\#`,
+	"|", `\|`,
+)
+
+func escapeMarkdown(s string) string {
+	s = strings.ReplaceAll(s, "\n", " ")
+	return markdownEscaper.Replace(s)
+}
@@ -0,0 +1,106 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FloatingPolicy says which time zone an imported event is stored in when
+// its source gives only a wall-clock time: an ICS DTSTART with neither "Z"
+// nor TZID, a CSV timestamp without an offset, or any Org timestamp.
+type FloatingPolicy string
+
+const (
+	// FloatingLocal pins the event to the zone its times were read in.
+	FloatingLocal FloatingPolicy = "local"
+	// FloatingUTC stores the event in UTC.
+	FloatingUTC FloatingPolicy = "utc"
+	// FloatingKeep leaves the event without a time zone, so Calendar
+	// applies its default zone.
+	FloatingKeep FloatingPolicy = "keep"
+)
+
+// ParseFloatingPolicy validates a --floating value.
+func ParseFloatingPolicy(s string) (FloatingPolicy, error) {
+	switch p := FloatingPolicy(strings.ToLower(strings.TrimSpace(s))); p {
+	case FloatingLocal, FloatingUTC, FloatingKeep:
+		return p, nil
+	default:
+		return "", fmt.Errorf("invalid floating policy %q (use local, utc, or keep)", s)
+	}
+}
+
+// ParseOption configures how the parsers read times that carry no zone.
+type ParseOption func(*parseOptions)
+
+// WithTimeZone reads floating times in loc instead of the local zone.
+func WithTimeZone(loc *time.Location) ParseOption {
+	return func(o *parseOptions) { o.loc = loc }
+}
+
+// WithFloating sets the storage policy for events with floating times.
+func WithFloating(p FloatingPolicy) ParseOption {
+	return func(o *parseOptions) { o.floating = p }
+}
+
+// WriteOption configures how events are written.
+type WriteOption func(*writeOptions)
+
+// WithDisplayZone writes CSV, Org and Markdown times, and split month and
+// event file names, in loc instead of the local zone. All-day events keep
+// their date.
+func WithDisplayZone(loc *time.Location) WriteOption {
+	return func(o *writeOptions) { o.loc = loc }
+}
+
+type writeOptions struct {
+	loc *time.Location
+}
+
+func newWriteOptions(opts []WriteOption) writeOptions {
+	o := writeOptions{loc: time.Local}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// in converts an event time to the write zone, keeping all-day dates
+// floating.
+func (o writeOptions) in(t time.Time, allDay bool) time.Time {
+	if !allDay {
+		return t.In(o.loc)
+	}
+	t = t.In(time.Local)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), o.loc)
+}
+
+type parseOptions struct {
+	loc      *time.Location
+	floating FloatingPolicy
+}
+
+func newParseOptions(opts []ParseOption) parseOptions {
+	o := parseOptions{loc: time.Local, floating: FloatingLocal}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// floatingZone returns the TimeZone to record on an event whose times were
+// floating, according to the policy.
+func (o parseOptions) floatingZone() string {
+	switch o.floating {
+	case FloatingUTC:
+		return "UTC"
+	case FloatingKeep:
+		return ""
+	default:
+		if o.loc == time.Local {
+			return localZoneName()
+		}
+		return o.loc.String()
+	}
+}
@@ -0,0 +1,529 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BRO3886/go-eventkit"
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+// Org exports events as an Emacs Org-mode outline. Each event becomes a
+// top-level heading with an active timestamp (so it shows up in the Org
+// agenda) and a property drawer carrying the location, calendar and ID.
+// Org timestamps have no zone; they are written in the local zone, or the
+// one given with WithDisplayZone.
+func Org(events []calendar.Event, w io.Writer, opts ...WriteOption) error {
+	o := newWriteOptions(opts)
+	for _, e := range events {
+		fmt.Fprintf(w, "* %s\n", orgTitle(e.Title))
+		fmt.Fprintf(w, "  %s\n", orgTimestamp(e, o))
+
+		fmt.Fprintln(w, "  :PROPERTIES:")
+		if e.Location != "" {
+			fmt.Fprintf(w, "  :LOCATION: %s\n", orgPropertyValue(e.Location))
+		}
+		if e.Calendar != "" {
+			fmt.Fprintf(w, "  :CALENDAR: %s\n", orgPropertyValue(e.Calendar))
+		}
+		if e.URL != "" {
+			fmt.Fprintf(w, "  :URL: %s\n", e.URL)
+		}
+		if e.ConferenceURL != "" {
+			fmt.Fprintf(w, "  :CONFERENCE: %s\n", e.ConferenceURL)
+		}
+		fmt.Fprintf(w, "  :ID: %s\n", e.ID)
+		fmt.Fprintln(w, "  :END:")
+
+		if e.Notes != "" {
+			for _, line := range strings.Split(e.Notes, "\n") {
+				fmt.Fprintf(w, "  %s\n", orgBodyLine(line))
+			}
+		}
+	}
+	return nil
+}
+
+// orgTimestamp renders an event's time span as an Org active timestamp:
+// "<2026-03-15 Sun 14:00-15:00>" for a same-day event, a "<...>--<...>" range
+// when it crosses midnight, and date-only stamps for all-day events (whose
+// exclusive EventKit end date is turned into Org's inclusive one).
+func orgTimestamp(e calendar.Event, o writeOptions) string {
+	start := o.in(e.StartDate, e.AllDay)
+	end := o.in(e.EndDate, e.AllDay)
+	repeater := orgRepeater(e.RecurrenceRules)
+
+	if e.AllDay {
+		last := end.AddDate(0, 0, -1)
+		if !last.After(start) || sameDay(start, last) {
+			return "<" + orgDate(start) + repeater + ">"
+		}
+		return "<" + orgDate(start) + repeater + ">--<" + orgDate(last) + ">"
+	}
+
+	if sameDay(start, end) {
+		return fmt.Sprintf("<%s %s-%s%s>", orgDate(start), start.Format("15:04"), end.Format("15:04"), repeater)
+	}
+	return fmt.Sprintf("<%s %s%s>--<%s %s>", orgDate(start), start.Format("15:04"), repeater, orgDate(end), end.Format("15:04"))
+}
+
+func orgDate(t time.Time) string {
+	return t.Format("2006-01-02 Mon")
+}
+
+// orgRepeater returns the " +Nu" cookie for a recurrence rule Org can
+// express. Org repeaters carry only a frequency and interval, so rules with
+// BYDAY/BYMONTHDAY constraints, several rules, or an end condition are left
+// out rather than exported as a wider series than the original.
+func orgRepeater(rules []eventkit.RecurrenceRule) string {
+	if len(rules) != 1 {
+		return ""
+	}
+	r := rules[0]
+	if len(r.DaysOfTheWeek) > 0 || len(r.DaysOfTheMonth) > 0 || r.End != nil {
+		return ""
+	}
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+	var unit string
+	switch r.Frequency {
+	case eventkit.FrequencyDaily:
+		unit = "d"
+	case eventkit.FrequencyWeekly:
+		unit = "w"
+	case eventkit.FrequencyMonthly:
+		unit = "m"
+	case eventkit.FrequencyYearly:
+		unit = "y"
+	default:
+		return ""
+	}
+	return fmt.Sprintf(" +%d%s", interval, unit)
+}
+
+// orgHeadingText flattens a title onto one line so it cannot break the
+// outline structure.
+func orgHeadingText(s string) string {
+	s = strings.ReplaceAll(s, "\r", "")
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+// orgTitle writes a title as heading text. A first word Org would read as
+// a TODO keyword or priority cookie, or a last word it would read as tags,
+// gets a backslash in front; words that already look escaped get another,
+// so splitOrgHeading restores the title exactly.
+func orgTitle(s string) string {
+	s = orgHeadingText(s)
+	words := strings.Fields(s)
+	if n := len(words); n > 1 && orgTagsLike(words[n-1]) {
+		i := strings.LastIndex(s, words[n-1])
+		s = s[:i] + `\` + s[i:]
+	}
+	if len(words) > 0 && orgKeywordLike(words[0]) {
+		i := strings.Index(s, words[0])
+		s = s[:i] + `\` + s[i:]
+	}
+	return s
+}
+
+// orgKeywordLike reports whether a heading's first word, less any
+// backslashes in front, is a TODO keyword or a priority cookie.
+func orgKeywordLike(word string) bool {
+	word = strings.TrimLeft(word, `\`)
+	return orgTodoWords[word] || (strings.HasPrefix(word, "[#") && strings.HasSuffix(word, "]"))
+}
+
+// orgTagsLike reports whether a heading's last word, less any backslashes
+// in front, is a tags string such as ":work:urgent:".
+func orgTagsLike(word string) bool {
+	return orgTagsWordRe.MatchString(strings.TrimLeft(word, `\`))
+}
+
+func orgPropertyValue(s string) string {
+	return orgHeadingText(s)
+}
+
+// orgBodyLine keeps a notes line from being read as a heading or a drawer
+// delimiter by Org. Like Org's own escaping, the comma goes after the
+// indentation, and lines that already look escaped get another comma so
+// orgUnescapeBody restores them exactly.
+func orgBodyLine(s string) string {
+	s = strings.TrimRight(s, "\r")
+	if orgEscaped(s) {
+		trimmed := strings.TrimLeft(s, " \t")
+		return s[:len(s)-len(trimmed)] + "," + trimmed
+	}
+	return s
+}
+
+// orgEscaped reports whether a body line needs, or after escaping has, a
+// comma in front: any commas after the indentation, then "*" or ":".
+func orgEscaped(s string) bool {
+	rest := strings.TrimLeft(strings.TrimLeft(s, " \t"), ",")
+	return strings.HasPrefix(rest, "*") || strings.HasPrefix(rest, ":")
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+// ParseOrg reads an Org-mode file and returns a CreateEventInput for every
+// heading that carries an active timestamp (on the heading itself, on a
+// SCHEDULED: line, or on its own line in the body). Headings without one are
+// treated as plain outline structure and skipped. LOCATION, CALENDAR and URL
+// are read from the property drawer; remaining body text becomes the notes.
+// Timestamps have no zone in Org, so they are floating: read in the local
+// zone (or WithTimeZone) and stored according to WithFloating.
+func ParseOrg(r io.Reader, opts ...ParseOption) ([]calendar.CreateEventInput, error) {
+	o := newParseOptions(opts)
+	var inputs []calendar.CreateEventInput
+	var cur *orgEntry
+
+	flush := func() error {
+		if cur == nil || cur.stamp == "" {
+			return nil
+		}
+		input, err := cur.toInput(o)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", cur.line, err)
+		}
+		inputs = append(inputs, input)
+		return nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	inDrawer := ""
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		if m := orgHeadingRe.FindStringSubmatch(line); m != nil {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			title, stamp := splitOrgHeading(m[2])
+			cur = &orgEntry{title: title, stamp: stamp, line: lineNo}
+			inDrawer = ""
+			continue
+		}
+		if cur == nil {
+			continue // preamble before the first heading (#+TITLE etc.)
+		}
+
+		trimmed := strings.TrimSpace(line)
+		if inDrawer != "" {
+			if strings.EqualFold(trimmed, ":END:") {
+				inDrawer = ""
+				continue
+			}
+			if inDrawer == "PROPERTIES" {
+				cur.setProperty(trimmed)
+			}
+			continue
+		}
+		if m := orgDrawerRe.FindStringSubmatch(trimmed); m != nil {
+			inDrawer = strings.ToUpper(m[1])
+			continue
+		}
+
+		if isOrgPlanningLine(trimmed) {
+			if cur.stamp == "" {
+				cur.stamp = orgScheduledStamp(trimmed)
+			}
+			continue
+		}
+		if cur.stamp == "" && orgStampLineRe.MatchString(trimmed) {
+			cur.stamp = trimmed
+			continue
+		}
+		if strings.HasPrefix(trimmed, "#+") {
+			continue
+		}
+		cur.body = append(cur.body, orgUnescapeBody(line))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read Org file: %w", err)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return inputs, nil
+}
+
+var (
+	orgHeadingRe  = regexp.MustCompile(`^(\*+)\s+(.*)$`)
+	orgDrawerRe   = regexp.MustCompile(`^:([A-Za-z_-]+):$`)
+	orgPropertyRe = regexp.MustCompile(`^:([^:]+):\s*(.*)$`)
+	orgTagsRe     = regexp.MustCompile(`\s+:[\w@#%:]+:\s*$`)
+	orgTagsWordRe = regexp.MustCompile(`^:[\w@#%:]+:$`)
+	// orgStampRe matches one active timestamp such as
+	// "<2026-03-15 Sun 14:00-15:00 +1w -1d>". Groups: date, start time,
+	// end time, repeater.
+	orgStampRe     = regexp.MustCompile(`<(\d{4}-\d{2}-\d{2})(?:\s+[^\s\d>]+)?(?:\s+(\d{1,2}:\d{2})(?:-(\d{1,2}:\d{2}))?)?((?:\s+(?:\.\+|\+\+|\+)\d+[hdwmy])?)(?:\s+-{1,2}\d+[hdwmy])?>`)
+	orgRangeRe     = regexp.MustCompile(`(<[^>]+>)--(<[^>]+>)`)
+	orgStampLineRe = regexp.MustCompile(`^<\d{4}-\d{2}-\d{2}[^>]*>(--<[^>]+>)?$`)
+	orgRepeaterRe  = regexp.MustCompile(`(\.\+|\+\+|\+)(\d+)([hdwmy])`)
+	orgTodoWords   = map[string]bool{"TODO": true, "DONE": true, "NEXT": true, "WAITING": true, "CANCELLED": true, "CANCELED": true}
+)
+
+// orgEntry accumulates one heading's section before conversion.
+type orgEntry struct {
+	title    string
+	stamp    string
+	location string
+	calendar string
+	url      string
+	body     []string
+	line     int
+}
+
+func (e *orgEntry) setProperty(line string) {
+	m := orgPropertyRe.FindStringSubmatch(line)
+	if m == nil {
+		return
+	}
+	val := strings.TrimSpace(m[2])
+	switch strings.ToUpper(m[1]) {
+	case "LOCATION":
+		e.location = val
+	case "CALENDAR":
+		e.calendar = val
+	case "URL":
+		e.url = val
+	}
+}
+
+func (e *orgEntry) toInput(o parseOptions) (calendar.CreateEventInput, error) {
+	if e.title == "" {
+		return calendar.CreateEventInput{}, fmt.Errorf("heading with timestamp %s has no title", e.stamp)
+	}
+	start, end, allDay, rules, err := parseOrgStamp(e.stamp, o.loc)
+	if err != nil {
+		return calendar.CreateEventInput{}, fmt.Errorf("%q: %w", e.title, err)
+	}
+	var tz string
+	if !allDay {
+		tz = o.floatingZone()
+	}
+	return calendar.CreateEventInput{
+		Title:           e.title,
+		StartDate:       start,
+		EndDate:         end,
+		AllDay:          allDay,
+		Location:        e.location,
+		Calendar:        e.calendar,
+		URL:             e.url,
+		Notes:           strings.TrimSpace(dedentOrgBody(e.body)),
+		RecurrenceRules: rules,
+		TimeZone:        tz,
+	}, nil
+}
+
+// splitOrgHeading strips the TODO keyword, priority cookie and tags from a
+// heading, and lifts out an active timestamp if the heading carries one.
+// Words escaped by orgTitle lose one backslash.
+func splitOrgHeading(text string) (title, stamp string) {
+	if loc := orgRangeRe.FindStringIndex(text); loc != nil {
+		stamp = text[loc[0]:loc[1]]
+		text = text[:loc[0]] + text[loc[1]:]
+	} else if loc := orgStampRe.FindStringIndex(text); loc != nil {
+		stamp = text[loc[0]:loc[1]]
+		text = text[:loc[0]] + text[loc[1]:]
+	}
+
+	text = orgTagsRe.ReplaceAllString(text, "")
+	fields := strings.Fields(text)
+	if len(fields) > 0 && orgTodoWords[fields[0]] {
+		fields = fields[1:]
+	}
+	if len(fields) > 0 && strings.HasPrefix(fields[0], "[#") && strings.HasSuffix(fields[0], "]") {
+		fields = fields[1:]
+	}
+	if len(fields) > 0 && strings.HasPrefix(fields[0], `\`) && orgKeywordLike(fields[0]) {
+		fields[0] = fields[0][1:]
+	}
+	if n := len(fields); n > 1 && strings.HasPrefix(fields[n-1], `\`) && orgTagsLike(fields[n-1]) {
+		fields[n-1] = fields[n-1][1:]
+	}
+	return strings.Join(fields, " "), stamp
+}
+
+func isOrgPlanningLine(line string) bool {
+	return strings.HasPrefix(line, "SCHEDULED:") || strings.HasPrefix(line, "DEADLINE:") || strings.HasPrefix(line, "CLOSED:")
+}
+
+// orgScheduledStamp returns the SCHEDULED timestamp from a planning line.
+// DEADLINE and CLOSED describe due dates and completion, not when something
+// happens, so they are ignored.
+func orgScheduledStamp(line string) string {
+	idx := strings.Index(line, "SCHEDULED:")
+	if idx < 0 {
+		return ""
+	}
+	rest := strings.TrimSpace(line[idx+len("SCHEDULED:"):])
+	if loc := orgRangeRe.FindStringIndex(rest); loc != nil && loc[0] == 0 {
+		return rest[:loc[1]]
+	}
+	if loc := orgStampRe.FindStringIndex(rest); loc != nil && loc[0] == 0 {
+		return rest[:loc[1]]
+	}
+	return ""
+}
+
+// parseOrgStamp converts an active timestamp or timestamp range into event
+// times. A date without a time is an all-day event; a start time without an
+// end lasts one hour, matching the ICS importer's default. An end equal to
+// the start is kept as a zero-length event, which is how Org writes one.
+func parseOrgStamp(stamp string, loc *time.Location) (start, end time.Time, allDay bool, rules []eventkit.RecurrenceRule, err error) {
+	first, second := stamp, ""
+	if m := orgRangeRe.FindStringSubmatch(stamp); m != nil {
+		first, second = m[1], m[2]
+	}
+
+	m := orgStampRe.FindStringSubmatch(first)
+	if m == nil {
+		return start, end, false, nil, fmt.Errorf("invalid timestamp %s", stamp)
+	}
+	day, err := time.ParseInLocation("2006-01-02", m[1], loc)
+	if err != nil {
+		return start, end, false, nil, fmt.Errorf("invalid date in %s: %w", stamp, err)
+	}
+	if rules, err = orgRepeaterRule(strings.TrimSpace(m[4])); err != nil {
+		return start, end, false, nil, err
+	}
+
+	allDay = m[2] == ""
+	if allDay {
+		start = day
+		end = day.AddDate(0, 0, 1)
+	} else {
+		if start, err = orgClock(day, m[2]); err != nil {
+			return start, end, false, nil, err
+		}
+		end = start.Add(time.Hour)
+		if m[3] != "" {
+			if end, err = orgClock(day, m[3]); err != nil {
+				return start, end, false, nil, err
+			}
+		}
+	}
+
+	if second != "" {
+		m2 := orgStampRe.FindStringSubmatch(second)
+		if m2 == nil {
+			return start, end, false, nil, fmt.Errorf("invalid timestamp %s", stamp)
+		}
+		lastDay, err := time.ParseInLocation("2006-01-02", m2[1], loc)
+		if err != nil {
+			return start, end, false, nil, fmt.Errorf("invalid date in %s: %w", stamp, err)
+		}
+		switch {
+		case allDay:
+			end = lastDay.AddDate(0, 0, 1)
+		case m2[2] != "":
+			if end, err = orgClock(lastDay, m2[2]); err != nil {
+				return start, end, false, nil, err
+			}
+		default:
+			end = lastDay.AddDate(0, 0, 1)
+		}
+	}
+
+	if end.Before(start) {
+		return start, end, false, nil, fmt.Errorf("timestamp %s ends before it starts", stamp)
+	}
+	return start, end, allDay, rules, nil
+}
+
+func orgClock(day time.Time, hhmm string) (time.Time, error) {
+	parts := strings.SplitN(hhmm, ":", 2)
+	h, err := strconv.Atoi(parts[0])
+	if err != nil || h > 23 {
+		return time.Time{}, fmt.Errorf("invalid time %q", hhmm)
+	}
+	m, err := strconv.Atoi(parts[1])
+	if err != nil || m > 59 {
+		return time.Time{}, fmt.Errorf("invalid time %q", hhmm)
+	}
+	return time.Date(day.Year(), day.Month(), day.Day(), h, m, 0, 0, day.Location()), nil
+}
+
+// orgRepeaterRule maps an Org repeater cookie onto a recurrence rule. The
+// catch-up ("++") and restart (".+") variants only differ from "+" when a
+// task is marked done, which has no calendar equivalent, so all three map
+// to the same rule. Hourly repeaters have no EventKit counterpart.
+func orgRepeaterRule(cookie string) ([]eventkit.RecurrenceRule, error) {
+	if cookie == "" {
+		return nil, nil
+	}
+	m := orgRepeaterRe.FindStringSubmatch(cookie)
+	if m == nil {
+		return nil, fmt.Errorf("invalid repeater %q", cookie)
+	}
+	n, err := strconv.Atoi(m[2])
+	if err != nil || n < 1 {
+		return nil, fmt.Errorf("invalid repeater interval %q", cookie)
+	}
+	var rule eventkit.RecurrenceRule
+	switch m[3] {
+	case "d":
+		rule = eventkit.Daily(n)
+	case "w":
+		rule = eventkit.Weekly(n)
+	case "m":
+		rule = eventkit.Monthly(n)
+	case "y":
+		rule = eventkit.Yearly(n)
+	default:
+		return nil, fmt.Errorf("unsupported repeater %q (hourly repeats are not supported)", cookie)
+	}
+	return []eventkit.RecurrenceRule{rule}, nil
+}
+
+// orgUnescapeBody reverses orgBodyLine's comma escape.
+func orgUnescapeBody(line string) string {
+	trimmed := strings.TrimLeft(line, " \t")
+	if strings.HasPrefix(trimmed, ",") && orgEscaped(line) {
+		return line[:len(line)-len(trimmed)] + trimmed[1:]
+	}
+	return line
+}
+
+// dedentOrgBody removes the indentation shared by all non-blank body lines,
+// so notes exported with Org's customary two-space indent come back flush.
+func dedentOrgBody(lines []string) string {
+	indent := -1
+	for _, l := range lines {
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+		n := len(l) - len(strings.TrimLeft(l, " \t"))
+		if indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	if indent <= 0 {
+		return strings.Join(lines, "\n")
+	}
+	out := make([]string, len(lines))
+	for i, l := range lines {
+		if len(l) >= indent {
+			out[i] = l[indent:]
+		} else {
+			// Only blank lines are shorter than the shared indent.
+			out[i] = ""
+		}
+	}
+	return strings.Join(out, "\n")
+}
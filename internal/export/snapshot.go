@@ -0,0 +1,114 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+// ReadSnapshot parses an export back into events for comparing with
+// another export or the live calendar. Unlike the Parse functions it keeps
+// what identifies an event rather than what is needed to create one: the
+// event ID (the UID in ICS) and, for JSON and ICS, the attendees. format is
+// "json", "csv" or "ics".
+func ReadSnapshot(format string, r io.Reader, opts ...ParseOption) ([]calendar.Event, error) {
+	o := newParseOptions(opts)
+	switch format {
+	case "json":
+		var exported []eventExport
+		if err := json.NewDecoder(r).Decode(&exported); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON: %w", err)
+		}
+		events := make([]calendar.Event, len(exported))
+		for i, e := range exported {
+			events[i] = calendar.Event{
+				ID:         e.ID,
+				Title:      e.Title,
+				StartDate:  e.StartDate,
+				EndDate:    e.EndDate,
+				AllDay:     e.AllDay,
+				Calendar:   e.Calendar,
+				CalendarID: e.CalendarID,
+				Location:   e.Location,
+				Notes:      e.Notes,
+				URL:        e.URL,
+				Recurring:  e.Recurring,
+				TimeZone:   e.TimeZone,
+				Organizer:  e.Organizer,
+			}
+			for _, a := range e.Attendees {
+				events[i].Attendees = append(events[i].Attendees, calendar.Attendee{
+					Name:   a.Name,
+					Email:  a.Email,
+					Status: parseParticipantStatus(a.Status),
+				})
+			}
+		}
+		return events, nil
+
+	case "csv":
+		inputs, ids, err := parseCSV(r, o)
+		if err != nil {
+			return nil, err
+		}
+		events := make([]calendar.Event, len(inputs))
+		for i, in := range inputs {
+			events[i] = snapshotEvent(in)
+			events[i].ID = ids[i]
+		}
+		return events, nil
+
+	case "ics":
+		scanned, _ := scanICS(r)
+		events := make([]calendar.Event, 0, len(scanned))
+		for _, e := range scanned {
+			in, err := e.toInput(o)
+			if err != nil {
+				return nil, err
+			}
+			ev := snapshotEvent(in)
+			ev.ID = e.uid
+			ev.Attendees = e.attendees
+			events = append(events, ev)
+		}
+		return events, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported snapshot format %q (use json, csv, or ics)", format)
+	}
+}
+
+// snapshotEvent turns a parsed input back into the event it was exported
+// from, as far as the input records it.
+func snapshotEvent(in calendar.CreateEventInput) calendar.Event {
+	return calendar.Event{
+		Title:           in.Title,
+		StartDate:       in.StartDate,
+		EndDate:         in.EndDate,
+		AllDay:          in.AllDay,
+		Calendar:        in.Calendar,
+		Location:        in.Location,
+		Notes:           in.Notes,
+		URL:             in.URL,
+		Alerts:          in.Alerts,
+		RecurrenceRules: in.RecurrenceRules,
+		Recurring:       len(in.RecurrenceRules) > 0,
+		TimeZone:        in.TimeZone,
+	}
+}
+
+func parseParticipantStatus(s string) calendar.ParticipantStatus {
+	for _, status := range []calendar.ParticipantStatus{
+		calendar.ParticipantStatusPending,
+		calendar.ParticipantStatusAccepted,
+		calendar.ParticipantStatusDeclined,
+		calendar.ParticipantStatusTentative,
+	} {
+		if status.String() == s {
+			return status
+		}
+	}
+	return calendar.ParticipantStatusUnknown
+}
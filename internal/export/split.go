@@ -0,0 +1,203 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+// SplitMode controls how Split partitions events into files.
+type SplitMode string
+
+const (
+	SplitCalendar SplitMode = "calendar"
+	SplitMonth    SplitMode = "month"
+	SplitEvent    SplitMode = "event"
+)
+
+// ManifestFile is the name of the index written alongside split exports.
+const ManifestFile = "index.json"
+
+// ParseSplitMode validates a --split value.
+func ParseSplitMode(s string) (SplitMode, error) {
+	switch m := SplitMode(strings.ToLower(strings.TrimSpace(s))); m {
+	case SplitCalendar, SplitMonth, SplitEvent:
+		return m, nil
+	default:
+		return "", fmt.Errorf("invalid split mode %q (use calendar, month, or event)", s)
+	}
+}
+
+// Group is one output file of a split export.
+type Group struct {
+	// Key is what the group was split on: the calendar name, the month
+	// ("2026-03"), or the event ID.
+	Key    string
+	File   string
+	Events []calendar.Event
+}
+
+// Split partitions events by mode and assigns each group a file name with
+// the given extension. Groups are sorted by key so repeated exports of the
+// same data produce the same files, which keeps diffs in git-tracked
+// archives small. Events keep their input order within a group. Months and
+// event times in file names are in the local zone, or the one given with
+// WithDisplayZone.
+func Split(events []calendar.Event, mode SplitMode, ext string, opts ...WriteOption) []Group {
+	o := newWriteOptions(opts)
+	byKey := make(map[string]*Group)
+	var keys []string
+	for _, e := range events {
+		key, base := splitKey(e, mode, o)
+		g, ok := byKey[key]
+		if !ok {
+			g = &Group{Key: key, File: base}
+			byKey[key] = g
+			keys = append(keys, key)
+		}
+		g.Events = append(g.Events, e)
+	}
+	sort.Strings(keys)
+
+	groups := make([]Group, 0, len(keys))
+	// The manifest is written next to the groups, so no group may take
+	// its name.
+	used := map[string]bool{ManifestFile: true}
+	for _, key := range keys {
+		g := byKey[key]
+		name := g.File + "." + ext
+		// Calendars whose names only differ in case or punctuation slug to
+		// the same base; number the later ones rather than overwrite.
+		for n := 2; used[strings.ToLower(name)]; n++ {
+			name = fmt.Sprintf("%s-%d.%s", g.File, n, ext)
+		}
+		used[strings.ToLower(name)] = true
+		g.File = name
+		groups = append(groups, *g)
+	}
+	return groups
+}
+
+func splitKey(e calendar.Event, mode SplitMode, o writeOptions) (key, base string) {
+	switch mode {
+	case SplitMonth:
+		key = o.in(e.StartDate, e.AllDay).Format("2006-01")
+		return key, key
+	case SplitEvent:
+		if e.ID != "" {
+			return e.ID, sanitizeFileName(e.ID)
+		}
+		key = slugify(e.Title) + "-" + o.in(e.StartDate, e.AllDay).Format("20060102T1504")
+		return key, key
+	default:
+		return e.Calendar, slugify(e.Calendar)
+	}
+}
+
+// slugify lowercases s and collapses everything that is not a letter or
+// digit into single hyphens: "Work / Team" becomes "work-team". Non-ASCII
+// letters are kept, since calendar names are often not English.
+func slugify(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	slug := strings.TrimSuffix(b.String(), "-")
+	if slug == "" {
+		return "untitled"
+	}
+	return slug
+}
+
+// sanitizeFileName keeps an event ID recognisable while replacing characters
+// that are unsafe in file names. EventKit IDs look like "UUID:UUID", and
+// the colon is not allowed on every filesystem.
+func sanitizeFileName(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if isFileNameRune(r) {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	name := strings.TrimLeft(b.String(), ".")
+	if name == "" {
+		return "untitled"
+	}
+	return name
+}
+
+func isFileNameRune(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' ||
+		r == '.' || r == '_' || r == '-'
+}
+
+// Manifest describes a split export. It is written as index.json next to
+// the exported files.
+type Manifest struct {
+	GeneratedAt time.Time       `json:"generated_at"`
+	Format      string          `json:"format"`
+	Split       SplitMode       `json:"split"`
+	From        time.Time       `json:"from"`
+	To          time.Time       `json:"to"`
+	Events      int             `json:"events"`
+	Files       []ManifestEntry `json:"files"`
+}
+
+// ManifestEntry lists one exported file and what it contains.
+type ManifestEntry struct {
+	File   string    `json:"file"`
+	Key    string    `json:"key"`
+	Events int       `json:"events"`
+	First  time.Time `json:"first"`
+	Last   time.Time `json:"last"`
+}
+
+// NewManifest builds the index for a set of groups returned by Split.
+func NewManifest(groups []Group, mode SplitMode, format string, from, to time.Time) Manifest {
+	m := Manifest{
+		GeneratedAt: time.Now(),
+		Format:      format,
+		Split:       mode,
+		From:        from,
+		To:          to,
+		Files:       make([]ManifestEntry, 0, len(groups)),
+	}
+	for _, g := range groups {
+		entry := ManifestEntry{File: g.File, Key: g.Key, Events: len(g.Events)}
+		for i, e := range g.Events {
+			if i == 0 || e.StartDate.Before(entry.First) {
+				entry.First = e.StartDate
+			}
+			if i == 0 || e.StartDate.After(entry.Last) {
+				entry.Last = e.StartDate
+			}
+		}
+		m.Events += entry.Events
+		m.Files = append(m.Files, entry)
+	}
+	return m
+}
+
+// WriteManifest writes the manifest as indented JSON.
+func WriteManifest(m Manifest, w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(m)
+}
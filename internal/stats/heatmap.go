@@ -0,0 +1,72 @@
+package stats
+
+import (
+	"sort"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+// Heatmap is how booked each hour of the week is over a date range.
+type Heatmap struct {
+	From time.Time
+	To   time.Time
+	// Booked[d][h] is the share, from 0 to 1, of hour h on weekday d that
+	// is booked, across every such hour in the range. Overlapping events
+	// count once.
+	Booked [7][24]float64
+}
+
+// ComputeHeatmap builds the heatmap for events in [from, to), by weekday
+// and hour in from's zone. Events are filtered with Counts.
+func ComputeHeatmap(events []calendar.Event, from, to time.Time) *Heatmap {
+	loc := from.Location()
+	to = to.In(loc)
+	h := &Heatmap{From: from, To: to}
+
+	var busy [][2]time.Time
+	for _, e := range events {
+		if !Counts(e) {
+			continue
+		}
+		start, end := clip(e.StartDate.In(loc), e.EndDate.In(loc), from, to)
+		if end.After(start) {
+			busy = append(busy, [2]time.Time{start, end})
+		}
+	}
+
+	var booked, capacity [7][24]time.Duration
+	for _, b := range merge(busy) {
+		spread(b[0], b[1], func(s, e time.Time) {
+			booked[s.Weekday()][s.Hour()] += e.Sub(s)
+		})
+	}
+	spread(from, to, func(s, e time.Time) {
+		capacity[s.Weekday()][s.Hour()] += e.Sub(s)
+	})
+
+	for d := range booked {
+		for hr := range booked[d] {
+			if capacity[d][hr] > 0 {
+				h.Booked[d][hr] = round2(float64(booked[d][hr]) / float64(capacity[d][hr]))
+			}
+		}
+	}
+	return h
+}
+
+// merge returns the union of intervals as sorted, non-overlapping ones.
+func merge(intervals [][2]time.Time) [][2]time.Time {
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i][0].Before(intervals[j][0]) })
+	var out [][2]time.Time
+	for _, iv := range intervals {
+		if n := len(out); n > 0 && !iv[0].After(out[n-1][1]) {
+			if iv[1].After(out[n-1][1]) {
+				out[n-1][1] = iv[1]
+			}
+			continue
+		}
+		out = append(out, iv)
+	}
+	return out
+}
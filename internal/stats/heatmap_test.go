@@ -0,0 +1,88 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+func TestComputeHeatmap(t *testing.T) {
+	events := []calendar.Event{
+		// Overlapping Monday events count once: 9:00–10:30 in week 1.
+		{Title: "Review", StartDate: at(2, 9, 0), EndDate: at(2, 10, 0)},
+		{Title: "Sync", StartDate: at(2, 9, 30), EndDate: at(2, 10, 30)},
+		// Every Wednesday 14:00 in the range.
+		{Title: "1:1", StartDate: at(4, 14, 0), EndDate: at(4, 15, 0)},
+		{Title: "1:1", StartDate: at(11, 14, 0), EndDate: at(11, 15, 0)},
+		// Not counted
+		{Title: "Hold", StartDate: at(3, 9, 0), EndDate: at(3, 17, 0), Availability: calendar.AvailabilityFree},
+		{Title: "Declined", StartDate: at(3, 9, 0), EndDate: at(3, 17, 0), SelfStatus: calendar.ParticipantStatusDeclined},
+		{Title: "Cancelled", StartDate: at(3, 9, 0), EndDate: at(3, 17, 0), Status: calendar.StatusCanceled},
+		{Title: "Offsite", StartDate: at(3, 0, 0), EndDate: at(4, 0, 0), AllDay: true},
+		// Starts before the range: only the part inside counts.
+		{Title: "Late", StartDate: at(1, 23, 0), EndDate: at(2, 0, 30)},
+	}
+
+	h := ComputeHeatmap(events, at(2, 0, 0), at(16, 0, 0))
+
+	tests := []struct {
+		name string
+		day  time.Weekday
+		hour int
+		want float64
+	}{
+		{"overlap, one of two Mondays", time.Monday, 9, 0.5},
+		{"partial hour", time.Monday, 10, 0.25},
+		{"clipped to range", time.Monday, 0, 0.25},
+		{"every Wednesday", time.Wednesday, 14, 1},
+		{"free, declined, cancelled, all-day", time.Tuesday, 9, 0},
+		{"empty", time.Friday, 12, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := h.Booked[tt.day][tt.hour]; got != tt.want {
+				t.Errorf("Booked[%v][%d] = %v, want %v", tt.day, tt.hour, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	got := merge([][2]time.Time{
+		{at(2, 13, 0), at(2, 14, 0)},
+		{at(2, 9, 0), at(2, 10, 0)},
+		{at(2, 9, 30), at(2, 11, 0)},
+		{at(2, 11, 0), at(2, 12, 0)},
+		{at(2, 9, 45), at(2, 10, 15)},
+	})
+	want := [][2]time.Time{{at(2, 9, 0), at(2, 12, 0)}, {at(2, 13, 0), at(2, 14, 0)}}
+	if len(got) != len(want) {
+		t.Fatalf("merge = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i][0].Equal(want[i][0]) || !got[i][1].Equal(want[i][1]) {
+			t.Errorf("merge[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestComputeHeatmapDST(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("no tzdata:", err)
+	}
+	defer func(l *time.Location) { time.Local = l }(time.Local)
+	time.Local = ny
+
+	// The range covers 2026-03-08, when 02:00–03:00 doesn't exist.
+	day := func(d, h int) time.Time { return time.Date(2026, 3, d, h, 0, 0, 0, ny) }
+	events := []calendar.Event{
+		{Title: "Deploy", StartDate: day(8, 1), EndDate: day(8, 4)},
+	}
+
+	h := ComputeHeatmap(events, day(8, 0), day(9, 0))
+	if got := h.Booked[time.Sunday]; got[1] != 1 || got[2] != 0 || got[3] != 1 {
+		t.Errorf("Sunday 01–03 = %v, %v, %v; want 1, 0, 1", got[1], got[2], got[3])
+	}
+}
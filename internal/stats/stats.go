@@ -0,0 +1,317 @@
+// Package stats summarizes how time in a date range is spent: hours per
+// calendar, organizer and attendee, meetings against focus time, and how
+// the load falls across weekdays, hours and weeks.
+package stats
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+// Options controls what Compute counts.
+type Options struct {
+	// From and To bound the range; events are clipped to it.
+	From, To time.Time
+	// DayStart and DayEnd are the working day as offsets from midnight,
+	// used for focus time.
+	DayStart, DayEnd time.Duration
+	// MinFocus is the shortest free stretch that counts as focus time.
+	MinFocus time.Duration
+	// WeekStart is the first day of the weeks in Report.Weeks.
+	WeekStart time.Weekday
+	// Top limits the calendar, organizer and attendee lists (0 = all).
+	Top int
+}
+
+// Report is the result of Compute. Hours are rounded to two decimals.
+type Report struct {
+	From   time.Time `json:"from"`
+	To     time.Time `json:"to"`
+	Events int       `json:"events"`
+	Hours  float64   `json:"hours"`
+
+	Meetings          int     `json:"meetings"`
+	MeetingHours      float64 `json:"meeting_hours"`
+	AvgMeetingMinutes float64 `json:"avg_meeting_minutes"`
+	FocusHours        float64 `json:"focus_hours"`
+	RecurringHours    float64 `json:"recurring_hours"`
+	OneOffHours       float64 `json:"one_off_hours"`
+
+	Calendars  []Share `json:"calendars"`
+	Organizers []Share `json:"organizers"`
+	Attendees  []Share `json:"attendees"`
+
+	Weekdays []WeekdayLoad `json:"weekdays"`
+	Hourly   []HourLoad    `json:"hourly"`
+	Weeks    []WeekLoad    `json:"weeks"`
+}
+
+// Share is the time spent with one calendar, organizer or attendee.
+type Share struct {
+	Name   string  `json:"name"`
+	Hours  float64 `json:"hours"`
+	Events int     `json:"events"`
+}
+
+// WeekdayLoad is the scheduled time on one day of the week.
+type WeekdayLoad struct {
+	Weekday time.Weekday `json:"-"`
+	Name    string       `json:"weekday"`
+	Hours   float64      `json:"hours"`
+}
+
+// HourLoad is the scheduled time within one hour of the day.
+type HourLoad struct {
+	Hour  int     `json:"hour"`
+	Hours float64 `json:"hours"`
+}
+
+// WeekLoad is one week of the range. Change is the difference in hours
+// from the week before.
+type WeekLoad struct {
+	Start        time.Time `json:"start"`
+	Events       int       `json:"events"`
+	Hours        float64   `json:"hours"`
+	MeetingHours float64   `json:"meeting_hours"`
+	Change       float64   `json:"change"`
+}
+
+// Counts reports whether an event takes up the user's time: timed, busy,
+// not declined and not cancelled.
+func Counts(e calendar.Event) bool {
+	return !e.AllDay &&
+		e.Availability != calendar.AvailabilityFree &&
+		e.SelfStatus != calendar.ParticipantStatusDeclined &&
+		e.Status != calendar.StatusCanceled
+}
+
+// IsMeeting reports whether an event has other people on it.
+func IsMeeting(e calendar.Event) bool {
+	return len(e.Attendees) > 0
+}
+
+// Compute builds the report for events in [opts.From, opts.To). Days and
+// hours are those of opts.From's zone.
+func Compute(events []calendar.Event, opts Options) *Report {
+	loc := opts.From.Location()
+	opts.To = opts.To.In(loc)
+	r := &Report{From: opts.From, To: opts.To}
+
+	calendars := map[string]*Share{}
+	organizers := map[string]*Share{}
+	attendees := map[string]*Share{}
+	var weekdays [7]time.Duration
+	var hourly [24]time.Duration
+	weeks := map[int64]*WeekLoad{}
+	var total, meetings, recurring time.Duration
+	var busy [][2]time.Time
+
+	add := func(m map[string]*Share, name string, d time.Duration) {
+		if name == "" {
+			return
+		}
+		s, ok := m[name]
+		if !ok {
+			s = &Share{Name: name}
+			m[name] = s
+		}
+		s.Hours += d.Hours()
+		s.Events++
+	}
+
+	for _, e := range events {
+		if !Counts(e) {
+			continue
+		}
+		start, end := clip(e.StartDate.In(loc), e.EndDate.In(loc), opts.From, opts.To)
+		if !end.After(start) {
+			continue
+		}
+		d := end.Sub(start)
+		r.Events++
+		total += d
+		busy = append(busy, [2]time.Time{start, end})
+
+		add(calendars, e.Calendar, d)
+		if e.Recurring {
+			recurring += d
+		}
+		if IsMeeting(e) {
+			r.Meetings++
+			meetings += d
+			add(organizers, e.Organizer, d)
+			seen := map[string]bool{}
+			for _, a := range e.Attendees {
+				name := attendeeName(a)
+				if !seen[name] {
+					seen[name] = true
+					add(attendees, name, d)
+				}
+			}
+		}
+
+		spread(start, end, func(from, to time.Time) {
+			weekdays[from.Weekday()] += to.Sub(from)
+			hourly[from.Hour()] += to.Sub(from)
+		})
+
+		wk := weekStart(start, opts.WeekStart)
+		w, ok := weeks[wk.Unix()]
+		if !ok {
+			w = &WeekLoad{Start: wk}
+			weeks[wk.Unix()] = w
+		}
+		w.Events++
+		w.Hours += d.Hours()
+		if IsMeeting(e) {
+			w.MeetingHours += d.Hours()
+		}
+	}
+
+	r.Hours = hours(total)
+	r.MeetingHours = hours(meetings)
+	r.RecurringHours = hours(recurring)
+	r.OneOffHours = hours(total - recurring)
+	if r.Meetings > 0 {
+		r.AvgMeetingMinutes = math.Round(meetings.Minutes()/float64(r.Meetings)*10) / 10
+	}
+	r.FocusHours = hours(focusTime(busy, opts))
+
+	r.Calendars = ranked(calendars, opts.Top)
+	r.Organizers = ranked(organizers, opts.Top)
+	r.Attendees = ranked(attendees, opts.Top)
+
+	for i := range 7 {
+		d := (opts.WeekStart + time.Weekday(i)) % 7
+		r.Weekdays = append(r.Weekdays, WeekdayLoad{Weekday: d, Name: d.String(), Hours: hours(weekdays[d])})
+	}
+	for h, d := range hourly {
+		r.Hourly = append(r.Hourly, HourLoad{Hour: h, Hours: hours(d)})
+	}
+
+	// Every week of the range is listed, so quiet weeks show as zero
+	// rather than disappearing from the trend.
+	for wk := weekStart(opts.From, opts.WeekStart); wk.Before(opts.To); wk = wk.AddDate(0, 0, 7) {
+		w := WeekLoad{Start: wk}
+		if got, ok := weeks[wk.Unix()]; ok {
+			w = *got
+		}
+		w.Hours = round2(w.Hours)
+		w.MeetingHours = round2(w.MeetingHours)
+		if n := len(r.Weeks); n > 0 {
+			w.Change = round2(w.Hours - r.Weeks[n-1].Hours)
+		}
+		r.Weeks = append(r.Weeks, w)
+	}
+	return r
+}
+
+// focusTime adds up free stretches of at least opts.MinFocus inside the
+// working day on weekdays.
+func focusTime(busy [][2]time.Time, opts Options) time.Duration {
+	sort.Slice(busy, func(i, j int) bool { return busy[i][0].Before(busy[j][0]) })
+
+	var total time.Duration
+	for day := startOfDay(opts.From); day.Before(opts.To); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			continue
+		}
+		from, to := clip(timeOfDay(day, opts.DayStart), timeOfDay(day, opts.DayEnd), opts.From, opts.To)
+		cursor := from
+		for _, b := range busy {
+			if !b[1].After(cursor) {
+				continue
+			}
+			if !b[0].Before(to) {
+				break
+			}
+			if gap := b[0].Sub(cursor); gap >= opts.MinFocus {
+				total += gap
+			}
+			cursor = b[1]
+		}
+		if gap := to.Sub(cursor); gap >= opts.MinFocus {
+			total += gap
+		}
+	}
+	return total
+}
+
+// spread calls fn for each piece of [start, end) split at local hour
+// boundaries. It steps by elapsed time to the next hour rather than by
+// wall clock, which stands still in a spring-forward gap.
+func spread(start, end time.Time, fn func(from, to time.Time)) {
+	for t := start; t.Before(end); {
+		past := time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+		next := t.Add(time.Hour - past)
+		if next.After(end) {
+			next = end
+		}
+		fn(t, next)
+		t = next
+	}
+}
+
+func clip(start, end, from, to time.Time) (time.Time, time.Time) {
+	if start.Before(from) {
+		start = from
+	}
+	if end.After(to) {
+		end = to
+	}
+	return start, end
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// timeOfDay returns the wall-clock time d after midnight on day, so a
+// 09:00 start stays 09:00 across DST changes.
+func timeOfDay(day time.Time, d time.Duration) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, int(d.Minutes()), 0, 0, day.Location())
+}
+
+func weekStart(t time.Time, first time.Weekday) time.Time {
+	day := startOfDay(t)
+	return day.AddDate(0, 0, -int((day.Weekday()-first+7)%7))
+}
+
+func attendeeName(a calendar.Attendee) string {
+	if a.Name != "" {
+		return a.Name
+	}
+	return strings.ToLower(a.Email)
+}
+
+// ranked sorts shares by hours, most first, and keeps the top n.
+func ranked(m map[string]*Share, n int) []Share {
+	out := make([]Share, 0, len(m))
+	for _, s := range m {
+		s.Hours = round2(s.Hours)
+		out = append(out, *s)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Hours != out[j].Hours {
+			return out[i].Hours > out[j].Hours
+		}
+		return out[i].Name < out[j].Name
+	})
+	if n > 0 && len(out) > n {
+		out = out[:n]
+	}
+	return out
+}
+
+func hours(d time.Duration) float64 {
+	return round2(d.Hours())
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,168 @@
+package stats
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+// at returns a time in the week of Monday 2 March 2026.
+func at(day, hour, min int) time.Time {
+	return time.Date(2026, 3, day, hour, min, 0, 0, time.Local)
+}
+
+func testOptions() Options {
+	return Options{
+		From:      at(2, 0, 0),
+		To:        at(16, 0, 0),
+		DayStart:  9 * time.Hour,
+		DayEnd:    17 * time.Hour,
+		MinFocus:  time.Hour,
+		WeekStart: time.Monday,
+	}
+}
+
+func TestCompute(t *testing.T) {
+	ana := calendar.Attendee{Name: "Ana", Email: "ana@example.com"}
+	bob := calendar.Attendee{Email: "Bob@Example.com"}
+	events := []calendar.Event{
+		// Week 1
+		{Title: "Standup", Calendar: "Work", StartDate: at(2, 9, 0), EndDate: at(2, 9, 30), Recurring: true, Organizer: "Ana", Attendees: []calendar.Attendee{ana, bob}},
+		{Title: "Planning", Calendar: "Work", StartDate: at(2, 13, 0), EndDate: at(2, 15, 0), Organizer: "Bob", Attendees: []calendar.Attendee{bob}},
+		{Title: "Gym", Calendar: "Home", StartDate: at(4, 18, 0), EndDate: at(4, 19, 0), Recurring: true},
+		// Week 2
+		{Title: "Standup", Calendar: "Work", StartDate: at(9, 9, 0), EndDate: at(9, 9, 30), Recurring: true, Organizer: "Ana", Attendees: []calendar.Attendee{ana, bob}},
+		// Not counted
+		{Title: "Holiday", Calendar: "Home", StartDate: at(10, 0, 0), EndDate: at(11, 0, 0), AllDay: true},
+		{Title: "Hold", Calendar: "Work", StartDate: at(10, 9, 0), EndDate: at(10, 17, 0), Availability: calendar.AvailabilityFree},
+		{Title: "Declined", Calendar: "Work", StartDate: at(10, 9, 0), EndDate: at(10, 17, 0), SelfStatus: calendar.ParticipantStatusDeclined},
+		{Title: "Cancelled", Calendar: "Work", StartDate: at(10, 9, 0), EndDate: at(10, 17, 0), Status: calendar.StatusCanceled},
+		// Clipped to the range: only the hour before it ends counts.
+		{Title: "Late", Calendar: "Work", StartDate: at(15, 23, 0), EndDate: at(16, 1, 0)},
+	}
+
+	r := Compute(events, testOptions())
+
+	if r.Events != 5 || r.Hours != 5 {
+		t.Errorf("events, hours = %d, %v; want 5, 5", r.Events, r.Hours)
+	}
+	if r.Meetings != 3 || r.MeetingHours != 3 || r.AvgMeetingMinutes != 60 {
+		t.Errorf("meetings = %d, %vh, avg %vm; want 3, 3h, 60m", r.Meetings, r.MeetingHours, r.AvgMeetingMinutes)
+	}
+	if r.RecurringHours != 2 || r.OneOffHours != 3 {
+		t.Errorf("recurring, one-off = %v, %v; want 2, 3", r.RecurringHours, r.OneOffHours)
+	}
+
+	wantCalendars := []Share{{"Work", 4, 4}, {"Home", 1, 1}}
+	if !reflect.DeepEqual(r.Calendars, wantCalendars) {
+		t.Errorf("calendars = %v, want %v", r.Calendars, wantCalendars)
+	}
+	wantOrganizers := []Share{{"Bob", 2, 1}, {"Ana", 1, 2}}
+	if !reflect.DeepEqual(r.Organizers, wantOrganizers) {
+		t.Errorf("organizers = %v, want %v", r.Organizers, wantOrganizers)
+	}
+	wantAttendees := []Share{{"bob@example.com", 3, 3}, {"Ana", 1, 2}}
+	if !reflect.DeepEqual(r.Attendees, wantAttendees) {
+		t.Errorf("attendees = %v, want %v", r.Attendees, wantAttendees)
+	}
+
+	if r.Weekdays[0].Weekday != time.Monday || r.Weekdays[0].Hours != 3 {
+		t.Errorf("first weekday = %+v, want Monday 3h", r.Weekdays[0])
+	}
+	if r.Weekdays[6].Weekday != time.Sunday || r.Weekdays[6].Hours != 1 {
+		t.Errorf("last weekday = %+v, want Sunday 1h", r.Weekdays[6])
+	}
+	if r.Hourly[9].Hours != 1 || r.Hourly[13].Hours != 1 || r.Hourly[14].Hours != 1 || r.Hourly[23].Hours != 1 {
+		t.Errorf("hourly = %v", r.Hourly)
+	}
+
+	if len(r.Weeks) != 2 {
+		t.Fatalf("weeks = %v, want 2", r.Weeks)
+	}
+	if w := r.Weeks[0]; w.Events != 3 || w.Hours != 3.5 || w.MeetingHours != 2.5 || w.Change != 0 {
+		t.Errorf("week 1 = %+v", w)
+	}
+	if w := r.Weeks[1]; w.Events != 2 || w.Hours != 1.5 || w.MeetingHours != 0.5 || w.Change != -2 {
+		t.Errorf("week 2 = %+v", w)
+	}
+}
+
+func TestComputeTop(t *testing.T) {
+	opts := testOptions()
+	opts.Top = 1
+	events := []calendar.Event{
+		{Calendar: "Work", StartDate: at(2, 9, 0), EndDate: at(2, 11, 0)},
+		{Calendar: "Home", StartDate: at(2, 12, 0), EndDate: at(2, 13, 0)},
+	}
+	if got := Compute(events, opts).Calendars; len(got) != 1 || got[0].Name != "Work" {
+		t.Errorf("calendars = %v, want only Work", got)
+	}
+}
+
+func TestFocusTime(t *testing.T) {
+	opts := testOptions()
+	opts.From, opts.To = at(2, 0, 0), at(3, 0, 0) // Monday only
+
+	tests := []struct {
+		name string
+		busy [][2]time.Time
+		want time.Duration
+	}{
+		{"empty day", nil, 8 * time.Hour},
+		{
+			"short gaps don't count",
+			[][2]time.Time{{at(2, 9, 30), at(2, 12, 0)}, {at(2, 12, 45), at(2, 17, 0)}},
+			0,
+		},
+		{
+			"overlapping events",
+			[][2]time.Time{{at(2, 10, 0), at(2, 12, 0)}, {at(2, 11, 0), at(2, 11, 30)}, {at(2, 14, 0), at(2, 15, 0)}},
+			1*time.Hour + 2*time.Hour + 2*time.Hour,
+		},
+		{
+			"events outside the workday",
+			[][2]time.Time{{at(2, 7, 0), at(2, 9, 30)}, {at(2, 16, 30), at(2, 19, 0)}},
+			7 * time.Hour,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := focusTime(tt.busy, opts); got != tt.want {
+				t.Errorf("focus = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Weekends have no workday.
+	opts.From, opts.To = at(7, 0, 0), at(9, 0, 0)
+	if got := focusTime(nil, opts); got != 0 {
+		t.Errorf("weekend focus = %v, want 0", got)
+	}
+}
+
+func TestComputeDST(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("no tzdata:", err)
+	}
+	defer func(l *time.Location) { time.Local = l }(time.Local)
+	time.Local = ny
+
+	// 2026-03-08 springs forward at 02:00, so 01:00–04:00 lasts two hours.
+	day := func(d, h int) time.Time { return time.Date(2026, 3, d, h, 0, 0, 0, ny) }
+	opts := testOptions()
+	opts.From, opts.To = day(2, 0), day(16, 0)
+	events := []calendar.Event{
+		{Title: "Deploy", Calendar: "Work", StartDate: day(8, 1), EndDate: day(8, 4)},
+	}
+
+	r := Compute(events, opts)
+	if r.Hours != 2 {
+		t.Errorf("hours = %v, want 2", r.Hours)
+	}
+	if r.Hourly[1].Hours != 1 || r.Hourly[2].Hours != 0 || r.Hourly[3].Hours != 1 {
+		t.Errorf("hourly = %v, want 1h at 01:00 and 03:00", r.Hourly)
+	}
+}
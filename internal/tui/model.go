@@ -0,0 +1,482 @@
+// Package tui implements `ical tui`, a full-screen calendar browser with
+// day, week and agenda panes.
+package tui
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/ical/internal/ui"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Backend is the part of calendar.Client the TUI talks to directly.
+type Backend interface {
+	Events(start, end time.Time, opts ...calendar.ListOption) ([]calendar.Event, error)
+	DeleteEvent(id string, span calendar.Span) error
+	RespondToInvitation(eventID string, status calendar.ParticipantStatus) error
+}
+
+// Actions are flows that need the whole terminal. The TUI suspends itself
+// while they run and reloads afterwards.
+type Actions struct {
+	// Add runs the add form with the start pre-filled.
+	Add func(start time.Time) error
+	// Edit runs the edit form for an event.
+	Edit func(e *calendar.Event) error
+	// Join opens a conference link.
+	Join func(url string) error
+}
+
+// Options configure the TUI.
+type Options struct {
+	Backend   Backend
+	Actions   Actions
+	Colors    ui.CalendarColors
+	WeekStart time.Weekday
+	Pane      Pane
+	// Now defaults to time.Now; tests pin it.
+	Now func() time.Time
+}
+
+// Pane is one of the main views.
+type Pane int
+
+const (
+	PaneDay Pane = iota
+	PaneWeek
+	PaneAgenda
+)
+
+var paneNames = []string{"Day", "Week", "Agenda"}
+
+func (p Pane) String() string { return paneNames[p] }
+
+// ParsePane maps a --view value to a Pane.
+func ParsePane(s string) (Pane, error) {
+	switch s {
+	case "day":
+		return PaneDay, nil
+	case "week", "":
+		return PaneWeek, nil
+	case "agenda":
+		return PaneAgenda, nil
+	}
+	return 0, fmt.Errorf("invalid view %q (use day, week, agenda)", s)
+}
+
+// agendaDays is how far ahead the agenda pane looks.
+const agendaDays = 14
+
+// mode is what the key handler is waiting for.
+type mode int
+
+const (
+	modeNormal mode = iota
+	modeDelete
+	modeRSVP
+)
+
+// Model is the bubbletea model.
+type Model struct {
+	opts Options
+	pane Pane
+	mode mode
+
+	day    time.Time // selected day, local midnight
+	anchor time.Time // first day of the agenda pane
+	sel    int       // selected event in the current list
+	detail bool
+
+	from, to time.Time // loaded range
+	events   []calendar.Event
+	loaded   bool
+
+	status string
+	width  int
+	height int
+}
+
+// eventsMsg carries a finished fetch.
+type eventsMsg struct {
+	from, to time.Time
+	events   []calendar.Event
+	err      error
+}
+
+// doneMsg reports the outcome of an action; the model reloads afterwards.
+type doneMsg struct {
+	status string
+	err    error
+}
+
+// New creates the model, selecting today.
+func New(opts Options) Model {
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	today := startOfDay(opts.Now())
+	return Model{
+		opts:   opts,
+		pane:   opts.Pane,
+		day:    today,
+		anchor: today,
+		width:  100,
+		height: 30,
+	}
+}
+
+// Run starts the TUI on the alternate screen.
+func Run(opts Options) error {
+	_, err := tea.NewProgram(New(opts), tea.WithAltScreen()).Run()
+	return err
+}
+
+// Init loads the initial range.
+func (m Model) Init() tea.Cmd {
+	return m.load()
+}
+
+// rangeFor returns three weeks from the start of the selected week, which
+// covers the day and week panes and the agenda's two weeks.
+func (m Model) rangeFor() (time.Time, time.Time) {
+	first := m.day
+	if m.pane == PaneAgenda {
+		first = m.anchor
+	}
+	from := ui.WeekStart(first, m.opts.WeekStart)
+	return from, from.AddDate(0, 0, 21)
+}
+
+// needsLoad reports whether the selection has left the loaded range.
+func (m Model) needsLoad() bool {
+	if !m.loaded {
+		return true
+	}
+	if m.pane == PaneAgenda {
+		return m.anchor.Before(m.from) || m.anchor.AddDate(0, 0, agendaDays).After(m.to)
+	}
+	weekFrom := ui.WeekStart(m.day, m.opts.WeekStart)
+	return weekFrom.Before(m.from) || weekFrom.AddDate(0, 0, 7).After(m.to)
+}
+
+func (m Model) load() tea.Cmd {
+	from, to := m.rangeFor()
+	backend := m.opts.Backend
+	return func() tea.Msg {
+		events, err := backend.Events(from, to)
+		return eventsMsg{from: from, to: to, events: events, err: err}
+	}
+}
+
+// Update handles messages.
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+
+	case eventsMsg:
+		if msg.err != nil {
+			m.status = "Error: " + msg.err.Error()
+			return m, nil
+		}
+		sort.SliceStable(msg.events, func(i, j int) bool {
+			return msg.events[i].StartDate.Before(msg.events[j].StartDate)
+		})
+		m.from, m.to, m.events, m.loaded = msg.from, msg.to, msg.events, true
+		m.clampSelection()
+		return m, nil
+
+	case doneMsg:
+		if msg.err != nil {
+			m.status = "Error: " + msg.err.Error()
+		} else {
+			m.status = msg.status
+		}
+		m.loaded = false
+		return m, m.load()
+
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+	return m, nil
+}
+
+func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	if key == "ctrl+c" {
+		return m, tea.Quit
+	}
+
+	switch m.mode {
+	case modeDelete:
+		return m.handleDeleteKey(key)
+	case modeRSVP:
+		return m.handleRSVPKey(key)
+	}
+
+	m.status = ""
+	switch key {
+	case "q":
+		return m, tea.Quit
+	case "1":
+		m.setPane(PaneDay)
+	case "2":
+		m.setPane(PaneWeek)
+	case "3":
+		m.setPane(PaneAgenda)
+	case "tab":
+		m.setPane((m.pane + 1) % 3)
+	case "shift+tab":
+		m.setPane((m.pane + 2) % 3)
+	case "left", "h":
+		m.moveDays(-1)
+	case "right", "l":
+		m.moveDays(1)
+	case "[", "pgup":
+		m.moveDays(-7)
+	case "]", "pgdown":
+		m.moveDays(7)
+	case "t":
+		today := startOfDay(m.opts.Now())
+		m.day, m.anchor, m.sel = today, today, 0
+	case "up", "k":
+		m.moveSelection(-1)
+	case "down", "j":
+		m.moveSelection(1)
+	case "enter", " ", "i":
+		m.detail = !m.detail
+	case "R", "ctrl+r":
+		m.loaded = false
+		m.status = "Reloaded."
+		return m, m.load()
+	case "a":
+		return m, m.add()
+	case "e":
+		return m, m.edit()
+	case "d":
+		if e := m.selected(); e != nil {
+			m.mode = modeDelete
+		}
+	case "r":
+		if e := m.selected(); e != nil {
+			m.mode = modeRSVP
+		}
+	case "J":
+		return m, m.join()
+	}
+
+	if m.needsLoad() {
+		return m, m.load()
+	}
+	return m, nil
+}
+
+func (m Model) handleDeleteKey(key string) (tea.Model, tea.Cmd) {
+	e := m.selected()
+	m.mode = modeNormal
+	if e == nil {
+		return m, nil
+	}
+
+	var span calendar.Span
+	switch {
+	case e.Recurring && key == "t":
+		span = calendar.SpanThisEvent
+	case e.Recurring && key == "f":
+		span = calendar.SpanFutureEvents
+	case !e.Recurring && key == "y":
+		span = calendar.SpanThisEvent
+	default:
+		m.status = "Delete cancelled."
+		return m, nil
+	}
+
+	backend, id, title := m.opts.Backend, e.ID, e.Title
+	return m, func() tea.Msg {
+		if err := backend.DeleteEvent(id, span); err != nil {
+			return doneMsg{err: fmt.Errorf("failed to delete event: %w", err)}
+		}
+		return doneMsg{status: fmt.Sprintf("Deleted %q.", title)}
+	}
+}
+
+func (m Model) handleRSVPKey(key string) (tea.Model, tea.Cmd) {
+	e := m.selected()
+	m.mode = modeNormal
+	if e == nil {
+		return m, nil
+	}
+
+	var status calendar.ParticipantStatus
+	switch key {
+	case "a", "y":
+		status = calendar.ParticipantStatusAccepted
+	case "d", "n":
+		status = calendar.ParticipantStatusDeclined
+	case "t", "m":
+		status = calendar.ParticipantStatusTentative
+	default:
+		m.status = "RSVP cancelled."
+		return m, nil
+	}
+
+	backend, id, title := m.opts.Backend, e.ID, e.Title
+	return m, func() tea.Msg {
+		if err := backend.RespondToInvitation(id, status); err != nil {
+			return doneMsg{err: fmt.Errorf("failed to RSVP: %w", err)}
+		}
+		return doneMsg{status: fmt.Sprintf("RSVP'd %s to %q.", status, title)}
+	}
+}
+
+// add suspends the TUI and runs the add form, starting at 09:00 on the
+// selected day or the next full hour today.
+func (m Model) add() tea.Cmd {
+	if m.opts.Actions.Add == nil {
+		return nil
+	}
+	start := m.day.Add(9 * time.Hour)
+	if now := m.opts.Now(); m.day.Equal(startOfDay(now)) {
+		start = now.Truncate(time.Hour).Add(time.Hour)
+	}
+	add := m.opts.Actions.Add
+	return tea.Exec(funcExec(func() error { return add(start) }), func(err error) tea.Msg {
+		return doneMsg{status: "Back from add.", err: err}
+	})
+}
+
+func (m Model) edit() tea.Cmd {
+	e := m.selected()
+	if e == nil || m.opts.Actions.Edit == nil {
+		return nil
+	}
+	edit, event := m.opts.Actions.Edit, *e
+	return tea.Exec(funcExec(func() error { return edit(&event) }), func(err error) tea.Msg {
+		return doneMsg{status: "Back from edit.", err: err}
+	})
+}
+
+func (m Model) join() tea.Cmd {
+	e := m.selected()
+	if e == nil {
+		return nil
+	}
+	if e.ConferenceURL == "" {
+		title := e.Title
+		return func() tea.Msg {
+			return doneMsg{err: fmt.Errorf("event %q has no conference link", title)}
+		}
+	}
+	join, url, title := m.opts.Actions.Join, e.ConferenceURL, e.Title
+	return func() tea.Msg {
+		if join == nil {
+			return doneMsg{status: url}
+		}
+		if err := join(url); err != nil {
+			return doneMsg{err: fmt.Errorf("failed to open conference link: %w", err)}
+		}
+		return doneMsg{status: fmt.Sprintf("Joining %q.", title)}
+	}
+}
+
+func (m *Model) setPane(p Pane) {
+	if p == PaneAgenda && m.pane != PaneAgenda {
+		m.anchor = m.day
+	}
+	m.pane = p
+	m.sel = 0
+}
+
+func (m *Model) moveDays(n int) {
+	m.day = m.day.AddDate(0, 0, n)
+	if m.pane == PaneAgenda {
+		m.anchor = m.anchor.AddDate(0, 0, n)
+		m.day = m.anchor
+	}
+	m.sel = 0
+}
+
+func (m *Model) moveSelection(n int) {
+	list := m.list()
+	if len(list) == 0 {
+		return
+	}
+	m.sel = max(0, min(len(list)-1, m.sel+n))
+	if m.pane == PaneAgenda {
+		m.day = startOfDay(ui.DisplayTime(list[m.sel].StartDate, list[m.sel].AllDay))
+		if m.day.Before(m.anchor) {
+			m.day = m.anchor
+		}
+	}
+}
+
+func (m *Model) clampSelection() {
+	n := len(m.list())
+	if m.sel >= n {
+		m.sel = max(0, n-1)
+	}
+}
+
+// list returns the events the selection moves through: the selected day's
+// for the day and week panes, the next two weeks' for the agenda.
+func (m Model) list() []calendar.Event {
+	if m.pane == PaneAgenda {
+		return m.eventsBetween(m.anchor, m.anchor.AddDate(0, 0, agendaDays))
+	}
+	return m.eventsOn(m.day)
+}
+
+func (m Model) selected() *calendar.Event {
+	list := m.list()
+	if m.sel < 0 || m.sel >= len(list) {
+		return nil
+	}
+	e := list[m.sel]
+	return &e
+}
+
+// eventsOn returns a day's events, all-day ones first.
+func (m Model) eventsOn(day time.Time) []calendar.Event {
+	events := m.eventsBetween(day, day.AddDate(0, 0, 1))
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].AllDay && !events[j].AllDay
+	})
+	return events
+}
+
+func (m Model) eventsBetween(from, to time.Time) []calendar.Event {
+	var out []calendar.Event
+	for _, e := range m.events {
+		start, end := ui.DisplayTime(e.StartDate, e.AllDay), ui.DisplayTime(e.EndDate, e.AllDay)
+		if !end.After(start) {
+			if !start.Before(from) && start.Before(to) {
+				out = append(out, e)
+			}
+			continue
+		}
+		if start.Before(to) && end.After(from) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// funcExec adapts a Go function to tea.ExecCommand so the huh forms can
+// run with the terminal released.
+type funcExec func() error
+
+func (f funcExec) Run() error { return f() }
+
+func (funcExec) SetStdin(io.Reader)  {}
+func (funcExec) SetStdout(io.Writer) {}
+func (funcExec) SetStderr(io.Writer) {}
+
+func startOfDay(t time.Time) time.Time {
+	t = t.In(ui.DisplayLocation())
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, ui.DisplayLocation())
+}
@@ -0,0 +1,277 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type fakeBackend struct {
+	events  []calendar.Event
+	deleted map[string]calendar.Span
+	rsvps   map[string]calendar.ParticipantStatus
+}
+
+func (f *fakeBackend) Events(start, end time.Time, opts ...calendar.ListOption) ([]calendar.Event, error) {
+	return f.events, nil
+}
+
+func (f *fakeBackend) DeleteEvent(id string, span calendar.Span) error {
+	f.deleted[id] = span
+	return nil
+}
+
+func (f *fakeBackend) RespondToInvitation(id string, status calendar.ParticipantStatus) error {
+	f.rsvps[id] = status
+	return nil
+}
+
+func at(day, hour, min int) time.Time {
+	return time.Date(2026, 3, day, hour, min, 0, 0, time.Local)
+}
+
+// newTestModel returns a loaded model on Tuesday 17 March 2026.
+func newTestModel(t *testing.T, pane Pane) (Model, *fakeBackend) {
+	t.Helper()
+	backend := &fakeBackend{
+		events: []calendar.Event{
+			{ID: "standup", Title: "Standup", StartDate: at(17, 9, 0), EndDate: at(17, 9, 30), Calendar: "Work", Recurring: true},
+			{ID: "review", Title: "Design review", StartDate: at(17, 11, 0), EndDate: at(17, 12, 0), Calendar: "Work"},
+			{ID: "offsite", Title: "Offsite", StartDate: at(17, 0, 0), EndDate: at(18, 0, 0), AllDay: true, Calendar: "Work"},
+			{ID: "dentist", Title: "Dentist", StartDate: at(19, 8, 0), EndDate: at(19, 9, 0), Calendar: "Home"},
+		},
+		deleted: map[string]calendar.Span{},
+		rsvps:   map[string]calendar.ParticipantStatus{},
+	}
+	m := New(Options{
+		Backend:   backend,
+		WeekStart: time.Monday,
+		Pane:      pane,
+		Now:       func() time.Time { return at(17, 10, 0) },
+	})
+	m = update(t, m, m.Init()())
+	return m, backend
+}
+
+func update(t *testing.T, m Model, msg tea.Msg) Model {
+	t.Helper()
+	next, _ := m.Update(msg)
+	return next.(Model)
+}
+
+// press sends keys and runs any command they return that isn't an exec.
+func press(t *testing.T, m Model, keys ...string) Model {
+	t.Helper()
+	for _, k := range keys {
+		var msg tea.KeyMsg
+		switch k {
+		case "left":
+			msg = tea.KeyMsg{Type: tea.KeyLeft}
+		case "right":
+			msg = tea.KeyMsg{Type: tea.KeyRight}
+		case "tab":
+			msg = tea.KeyMsg{Type: tea.KeyTab}
+		default:
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		}
+		next, cmd := m.Update(msg)
+		m = next.(Model)
+		for cmd != nil {
+			out := cmd()
+			cmd = nil
+			switch out.(type) {
+			case doneMsg, eventsMsg:
+				next, cmd = m.Update(out)
+				m = next.(Model)
+			}
+		}
+	}
+	return m
+}
+
+func TestNavigation(t *testing.T) {
+	tests := []struct {
+		keys []string
+		want time.Time
+	}{
+		{[]string{"l"}, at(18, 0, 0)},
+		{[]string{"right", "right"}, at(19, 0, 0)},
+		{[]string{"h"}, at(16, 0, 0)},
+		{[]string{"]"}, at(24, 0, 0)},
+		{[]string{"[", "["}, time.Date(2026, 3, 3, 0, 0, 0, 0, time.Local)},
+		{[]string{"]", "l", "t"}, at(17, 0, 0)},
+	}
+	for _, tt := range tests {
+		m, _ := newTestModel(t, PaneWeek)
+		m = press(t, m, tt.keys...)
+		if !m.day.Equal(tt.want) {
+			t.Errorf("keys %v: day = %s, want %s", tt.keys, m.day.Format(time.DateOnly), tt.want.Format(time.DateOnly))
+		}
+		if m.needsLoad() {
+			t.Errorf("keys %v: selection outside the loaded range", tt.keys)
+		}
+	}
+}
+
+func TestSelection(t *testing.T) {
+	m, _ := newTestModel(t, PaneDay)
+
+	// All-day events come first.
+	if e := m.selected(); e == nil || e.ID != "offsite" {
+		t.Fatalf("selected = %v, want offsite", e)
+	}
+	m = press(t, m, "j", "j", "j", "j")
+	if e := m.selected(); e == nil || e.ID != "review" {
+		t.Errorf("after j×4 selected = %v, want review (clamped)", e)
+	}
+	m = press(t, m, "k")
+	if e := m.selected(); e == nil || e.ID != "standup" {
+		t.Errorf("after k selected = %v, want standup", e)
+	}
+
+	// Changing day resets the selection.
+	m = press(t, m, "l", "l")
+	if e := m.selected(); e == nil || e.ID != "dentist" {
+		t.Errorf("on 19 March selected = %v, want dentist", e)
+	}
+	m = press(t, m, "l")
+	if e := m.selected(); e != nil {
+		t.Errorf("on an empty day selected = %v, want nil", e)
+	}
+}
+
+func TestPanes(t *testing.T) {
+	m, _ := newTestModel(t, PaneWeek)
+	m = press(t, m, "l", "3")
+	if m.pane != PaneAgenda || !m.anchor.Equal(at(18, 0, 0)) {
+		t.Fatalf("pane = %s, anchor = %s; want Agenda from 18 March", m.pane, m.anchor.Format(time.DateOnly))
+	}
+	// The offsite ends at midnight, so only the dentist is left.
+	if got := len(m.list()); got != 1 {
+		t.Errorf("agenda from 18 March has %d events, want 1", got)
+	}
+	m = press(t, m, "h")
+	if got := len(m.list()); got != 4 {
+		t.Errorf("agenda from 17 March has %d events, want 4", got)
+	}
+	m = press(t, m, "j", "j", "j")
+	if !m.day.Equal(at(19, 0, 0)) {
+		t.Errorf("selecting in the agenda moved day to %s, want 2026-03-19", m.day.Format(time.DateOnly))
+	}
+
+	m = press(t, m, "tab")
+	if m.pane != PaneDay {
+		t.Errorf("tab from Agenda = %s, want Day", m.pane)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		down     int
+		keys     []string
+		wantID   string
+		wantSpan calendar.Span
+	}{
+		{"single confirmed", 2, []string{"d", "y"}, "review", calendar.SpanThisEvent},
+		{"single cancelled", 2, []string{"d", "n"}, "", 0},
+		{"recurring this", 1, []string{"d", "t"}, "standup", calendar.SpanThisEvent},
+		{"recurring future", 1, []string{"d", "f"}, "standup", calendar.SpanFutureEvents},
+		{"recurring y cancels", 1, []string{"d", "y"}, "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, backend := newTestModel(t, PaneDay)
+			for i := 0; i < tt.down; i++ {
+				m = press(t, m, "j")
+			}
+			m = press(t, m, tt.keys...)
+
+			if tt.wantID == "" {
+				if len(backend.deleted) != 0 {
+					t.Errorf("deleted %v, want nothing", backend.deleted)
+				}
+				if m.status != "Delete cancelled." {
+					t.Errorf("status = %q", m.status)
+				}
+				return
+			}
+			span, ok := backend.deleted[tt.wantID]
+			if !ok || span != tt.wantSpan {
+				t.Errorf("deleted = %v, want %s with span %d", backend.deleted, tt.wantID, tt.wantSpan)
+			}
+			if m.mode != modeNormal {
+				t.Errorf("mode = %d after delete, want normal", m.mode)
+			}
+		})
+	}
+}
+
+func TestRSVP(t *testing.T) {
+	tests := []struct {
+		key  string
+		want calendar.ParticipantStatus
+	}{
+		{"a", calendar.ParticipantStatusAccepted},
+		{"d", calendar.ParticipantStatusDeclined},
+		{"t", calendar.ParticipantStatusTentative},
+	}
+	for _, tt := range tests {
+		m, backend := newTestModel(t, PaneDay)
+		m = press(t, m, "j", "r", tt.key)
+		if got := backend.rsvps["standup"]; got != tt.want {
+			t.Errorf("r %s: status = %s, want %s", tt.key, got, tt.want)
+		}
+	}
+
+	m, backend := newTestModel(t, PaneDay)
+	m = press(t, m, "r", "x")
+	if len(backend.rsvps) != 0 || m.status != "RSVP cancelled." {
+		t.Errorf("r x: rsvps = %v, status = %q; want cancelled", backend.rsvps, m.status)
+	}
+}
+
+func TestView(t *testing.T) {
+	for _, pane := range []Pane{PaneDay, PaneWeek, PaneAgenda} {
+		m, _ := newTestModel(t, pane)
+		m = update(t, m, tea.WindowSizeMsg{Width: 140, Height: 20})
+		m = press(t, m, "enter")
+		view := m.View()
+
+		want := []string{"ical", "Offsite", "Standup", "Design review"}
+		if pane == PaneWeek {
+			want = []string{"ical", "■ Offsite", "09:00 Stan", "08:00 Dent"}
+		}
+		for _, want := range want {
+			if !strings.Contains(view, want) {
+				t.Errorf("%s view missing %q:\n%s", pane, want, view)
+			}
+		}
+		if lines := strings.Count(view, "\n") + 1; lines > 20 {
+			t.Errorf("%s view is %d lines, want at most 20", pane, lines)
+		}
+	}
+}
+
+func TestParsePane(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Pane
+		wantErr bool
+	}{
+		{"day", PaneDay, false},
+		{"week", PaneWeek, false},
+		{"", PaneWeek, false},
+		{"agenda", PaneAgenda, false},
+		{"month", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParsePane(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParsePane(%q) = %v, %v; want %v, err %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
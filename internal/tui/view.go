@@ -0,0 +1,275 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/ical/internal/ui"
+	"github.com/charmbracelet/lipgloss"
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+var (
+	titleStyle    = lipgloss.NewStyle().Bold(true)
+	tabStyle      = lipgloss.NewStyle().Padding(0, 1)
+	activeTab     = tabStyle.Reverse(true).Bold(true)
+	selectedStyle = lipgloss.NewStyle().Reverse(true)
+	dayStyle      = lipgloss.NewStyle().Bold(true)
+	todayStyle    = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("1"))
+	faintStyle    = lipgloss.NewStyle().Faint(true)
+	promptStyle   = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("3"))
+	detailStyle   = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1)
+)
+
+// View renders the screen: header, the active pane with an optional detail
+// panel, and a footer with key help or the current prompt.
+func (m Model) View() string {
+	header := m.viewHeader()
+	footer := m.viewFooter()
+	bodyHeight := max(3, m.height-lipgloss.Height(header)-lipgloss.Height(footer))
+
+	mainWidth := m.width
+	var detail string
+	if m.detail {
+		detailWidth := min(48, m.width/2)
+		mainWidth = m.width - detailWidth
+		detail = m.viewDetail(detailWidth, bodyHeight)
+	}
+
+	var body string
+	switch m.pane {
+	case PaneDay:
+		body = m.viewDay(mainWidth, bodyHeight)
+	case PaneWeek:
+		body = m.viewWeek(mainWidth, bodyHeight)
+	default:
+		body = m.viewAgenda(mainWidth, bodyHeight)
+	}
+	body = lipgloss.NewStyle().Width(mainWidth).Height(bodyHeight).MaxHeight(bodyHeight).Render(body)
+	if detail != "" {
+		body = lipgloss.JoinHorizontal(lipgloss.Top, body, detail)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, header, body, footer)
+}
+
+func (m Model) viewHeader() string {
+	var tabs []string
+	for p := PaneDay; p <= PaneAgenda; p++ {
+		label := fmt.Sprintf("%d %s", p+1, p)
+		if p == m.pane {
+			tabs = append(tabs, activeTab.Render(label))
+		} else {
+			tabs = append(tabs, tabStyle.Render(label))
+		}
+	}
+
+	loc := ui.CurrentLocale()
+	span := func(from time.Time, days int) string {
+		to := from.AddDate(0, 0, days-1)
+		return loc.DayMonth(from) + " – " + loc.DayMonth(to) + to.Format(" 2006")
+	}
+	var label string
+	switch m.pane {
+	case PaneDay:
+		label = loc.LongDate(m.day, true)
+	case PaneWeek:
+		label = span(ui.WeekStart(m.day, m.opts.WeekStart), 7)
+	default:
+		label = span(m.anchor, agendaDays)
+	}
+
+	return titleStyle.Render("ical") + "  " + strings.Join(tabs, "") + "  " + label + "\n"
+}
+
+func (m Model) viewFooter() string {
+	var line string
+	switch m.mode {
+	case modeDelete:
+		e := m.selected()
+		if e != nil && e.Recurring {
+			line = promptStyle.Render(fmt.Sprintf("Delete %q: [t]his event, [f]uture events, any other key cancels", e.Title))
+		} else if e != nil {
+			line = promptStyle.Render(fmt.Sprintf("Delete %q? [y/N]", e.Title))
+		}
+	case modeRSVP:
+		line = promptStyle.Render("RSVP: [a]ccept, [d]ecline, [t]entative, any other key cancels")
+	default:
+		if m.status != "" {
+			line = m.status
+		} else {
+			line = faintStyle.Render("←/→ day  [/] week  ↑/↓ event  t today  tab view  enter details  a add  e edit  d delete  r rsvp  J join  q quit")
+		}
+	}
+	return "\n" + truncateANSI(line, m.width)
+}
+
+// viewDay lists the selected day's events.
+func (m Model) viewDay(width, height int) string {
+	events := m.eventsOn(m.day)
+	if !m.loaded {
+		return faintStyle.Render("Loading…")
+	}
+	if len(events) == 0 {
+		return faintStyle.Render("No events. Press a to add one.")
+	}
+
+	lines := make([]string, len(events))
+	for i, e := range events {
+		lines[i] = m.eventLine(e, m.day, width, i == m.sel, true)
+	}
+	return strings.Join(window(lines, m.sel, height), "\n")
+}
+
+// viewWeek shows seven columns, each listing its day's events.
+func (m Model) viewWeek(width, height int) string {
+	start := ui.WeekStart(m.day, m.opts.WeekStart)
+	today := startOfDay(m.opts.Now())
+	col := max(8, width/7)
+
+	columns := make([]string, 7)
+	for i := range columns {
+		day := start.AddDate(0, 0, i)
+		head := fit(ui.CurrentLocale().ShortDate(day), col-1)
+		switch {
+		case day.Equal(m.day):
+			head = selectedStyle.Render(head)
+		case day.Equal(today):
+			head = todayStyle.Render(head)
+		default:
+			head = dayStyle.Render(head)
+		}
+
+		lines := []string{head}
+		events := m.eventsOn(day)
+		for j, e := range events {
+			selected := day.Equal(m.day) && j == m.sel
+			lines = append(lines, m.eventLine(e, day, col-1, selected, false))
+		}
+		if len(lines) > height {
+			lines = append(lines[:height-1], faintStyle.Render(fit(fmt.Sprintf("+%d more", len(lines)-height+1), col-1)))
+		}
+		columns[i] = lipgloss.NewStyle().Width(col).Render(strings.Join(lines, "\n"))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, columns...)
+}
+
+// viewAgenda lists two weeks of events under day headers.
+func (m Model) viewAgenda(width, height int) string {
+	if !m.loaded {
+		return faintStyle.Render("Loading…")
+	}
+	events := m.list()
+	if len(events) == 0 {
+		return faintStyle.Render("Nothing in the next two weeks.")
+	}
+
+	today := startOfDay(m.opts.Now())
+	var lines []string
+	selLine := 0
+	var lastDay time.Time
+	for i, e := range events {
+		day := startOfDay(e.StartDate)
+		if day.Before(m.anchor) {
+			day = m.anchor
+		}
+		if !day.Equal(lastDay) {
+			if len(lines) > 0 {
+				lines = append(lines, "")
+			}
+			head := ui.CurrentLocale().LongDate(day, false)
+			if day.Equal(today) {
+				lines = append(lines, todayStyle.Render("Today — "+head))
+			} else {
+				lines = append(lines, dayStyle.Render(head))
+			}
+			lastDay = day
+		}
+		if i == m.sel {
+			selLine = len(lines)
+		}
+		lines = append(lines, m.eventLine(e, day, width, i == m.sel, true))
+	}
+	return strings.Join(window(lines, selLine, height), "\n")
+}
+
+// viewDetail shows the selected event with the same fields as `ical show`.
+func (m Model) viewDetail(width, height int) string {
+	e := m.selected()
+	content := faintStyle.Render("No event selected.")
+	if e != nil {
+		content = strings.TrimRight(ui.EventDetail(e), "\n")
+	}
+	// The border and padding take four columns and two rows.
+	return detailStyle.Width(width - 2).MaxHeight(height).Render(
+		lipgloss.NewStyle().Width(width - 4).Render(content))
+}
+
+// eventLine renders one event. Long lines include the calendar name.
+func (m Model) eventLine(e calendar.Event, day time.Time, width int, selected, long bool) string {
+	loc := ui.CurrentLocale()
+	var when string
+	switch {
+	case e.AllDay:
+		when = "all day"
+	default:
+		start, end := ui.DisplayTime(e.StartDate, e.AllDay), ui.DisplayTime(e.EndDate, e.AllDay)
+		if start.Before(day) {
+			when = "…"
+		} else {
+			when = loc.Clock(start)
+		}
+		if long {
+			if end.After(day.AddDate(0, 0, 1)) {
+				when += "–…"
+			} else {
+				when += "–" + loc.Clock(end)
+			}
+		}
+	}
+
+	text := when + " " + e.Title
+	if e.AllDay && !long {
+		text = "■ " + e.Title
+	}
+	if long {
+		text = fmt.Sprintf("%-*s %s", 2*loc.ClockWidth()+3, when, e.Title)
+		if e.Calendar != "" {
+			text += "  (" + e.Calendar + ")"
+		}
+	}
+	text = fit("▌"+text, width)
+
+	if selected {
+		return selectedStyle.Render(text)
+	}
+	if m.opts.Colors != nil {
+		return m.opts.Colors.For(e.Calendar).Sprint(text)
+	}
+	return text
+}
+
+// window returns at most height lines, scrolled so line sel is visible.
+func window(lines []string, sel, height int) []string {
+	if len(lines) <= height {
+		return lines
+	}
+	start := max(0, min(sel-height/2, len(lines)-height))
+	return lines[start : start+height]
+}
+
+func fit(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	return runewidth.FillRight(runewidth.Truncate(s, width, "…"), width)
+}
+
+func truncateANSI(s string, width int) string {
+	if width <= 0 || lipgloss.Width(s) <= width {
+		return s
+	}
+	return lipgloss.NewStyle().MaxWidth(width).Render(s)
+}
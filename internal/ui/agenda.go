@@ -0,0 +1,188 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/fatih/color"
+)
+
+// agendaMinGap is the shortest gap between events shown as free time.
+const agendaMinGap = 15 * time.Minute
+
+// agendaItem is one event as it appears under one day. Events spanning
+// several days appear under each, clipped to that day.
+type agendaItem struct {
+	index int // 1-based row number
+	event calendar.Event
+	start time.Time // clipped to the day
+	end   time.Time
+	// continued and continues mark a clipped start or end.
+	continued bool
+	continues bool
+	conflicts bool
+}
+
+type agendaDay struct {
+	date   time.Time
+	allDay []agendaItem
+	timed  []agendaItem
+}
+
+// Events — Agenda
+
+// printEventsAgenda groups events under day headers with free time between
+// them and a marker for the current time.
+func printEventsAgenda(events []calendar.Event, w io.Writer, now time.Time) {
+	if len(events) == 0 {
+		fmt.Fprintln(w, "No events found.")
+		return
+	}
+
+	bold := color.New(color.Bold)
+	faint := color.New(color.Faint)
+	red := color.New(color.FgRed, color.Bold)
+	today := startOfLocalDay(now)
+	// Two clock times and a dash, plus room to spare.
+	timeWidth := 2*locale.ClockWidth() + 3
+
+	for i, d := range agendaDays(events) {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		bold.Fprintln(w, agendaDayLabel(d.date, today))
+
+		for _, it := range d.allDay {
+			fmt.Fprintf(w, " %s  %-*s  %s\n", agendaIndex(it.index), timeWidth, "All day", agendaTitle(it))
+		}
+
+		isToday := d.date.Equal(today)
+		nowShown := !isToday
+		var busyUntil time.Time
+		for _, it := range d.timed {
+			if !busyUntil.IsZero() && it.start.Sub(busyUntil) >= agendaMinGap {
+				fmt.Fprintln(w, faint.Sprintf("       %-*s  free %s", timeWidth, locale.Clock(busyUntil)+"–"+locale.Clock(it.start), CompactDuration(it.start.Sub(busyUntil))))
+			}
+			if !nowShown && now.Before(it.start) {
+				fmt.Fprintln(w, red.Sprintf("       ── now %s ──", locale.Clock(now)))
+				nowShown = true
+			}
+
+			marker, note := " ", ""
+			if isToday && !now.Before(it.event.StartDate) && now.Before(it.event.EndDate) {
+				marker = red.Sprint("▶")
+				note = red.Sprintf("  (now, %s left)", CompactDuration(it.event.EndDate.Sub(now)))
+				nowShown = true
+			}
+			fmt.Fprintf(w, "%s%s  %-*s  %s%s\n", marker, agendaIndex(it.index), timeWidth, agendaTimeRange(it), agendaTitle(it), note)
+
+			if it.end.After(busyUntil) {
+				busyUntil = it.end
+			}
+		}
+		if !nowShown {
+			fmt.Fprintln(w, red.Sprintf("       ── now %s ──", locale.Clock(now)))
+		}
+	}
+}
+
+// agendaDays splits events into days, in date order. Row numbers follow
+// the order events were given in, so they match the saved last list.
+func agendaDays(events []calendar.Event) []agendaDay {
+	byDate := map[time.Time]*agendaDay{}
+	conflicts := findConflicts(events)
+	for i, e := range events {
+		start, end := eventTimes(e)
+		day := startOfLocalDay(start)
+		for {
+			next := day.AddDate(0, 0, 1)
+			if !overlapsDay(start, end, day, next) {
+				break
+			}
+			d, ok := byDate[day]
+			if !ok {
+				d = &agendaDay{date: day}
+				byDate[day] = d
+			}
+			it := agendaItem{index: i + 1, event: e, start: start, end: end, conflicts: len(conflicts[i]) > 0}
+			if e.AllDay {
+				d.allDay = append(d.allDay, it)
+			} else {
+				if start.Before(day) {
+					it.start, it.continued = day, true
+				}
+				if end.After(next) {
+					it.end, it.continues = next, true
+				}
+				d.timed = append(d.timed, it)
+			}
+			day = next
+		}
+	}
+
+	days := make([]agendaDay, 0, len(byDate))
+	for _, d := range byDate {
+		sort.SliceStable(d.timed, func(i, j int) bool {
+			return d.timed[i].start.Before(d.timed[j].start)
+		})
+		days = append(days, *d)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].date.Before(days[j].date) })
+	return days
+}
+
+// agendaDayLabel names a day relative to today where it can ("Today —
+// Tuesday 17 March"), adding the year outside the current one.
+func agendaDayLabel(day, today time.Time) string {
+	label := locale.LongDate(day, day.Year() != today.Year())
+	switch {
+	case day.Equal(today):
+		return "Today — " + label
+	case day.Equal(today.AddDate(0, 0, 1)):
+		return "Tomorrow — " + label
+	case day.Equal(today.AddDate(0, 0, -1)):
+		return "Yesterday — " + label
+	}
+	return label
+}
+
+func agendaIndex(n int) string {
+	return fmt.Sprintf("%4s", fmt.Sprintf("#%d", n))
+}
+
+func agendaTimeRange(it agendaItem) string {
+	start, end := locale.Clock(it.start), locale.Clock(it.end)
+	if it.continued {
+		start = "…"
+	}
+	if it.continues {
+		end = "…"
+	}
+	return start + "–" + end
+}
+
+func agendaTitle(it agendaItem) string {
+	e := it.event
+	var b strings.Builder
+	b.WriteString(e.Title)
+	if e.Recurring {
+		b.WriteString(" " + color.HiCyanString("↻"))
+	}
+	if it.conflicts {
+		b.WriteString(" " + conflictMarker())
+	}
+	b.WriteString("  " + calendarBullet(e.Calendar) + color.New(color.Faint).Sprintf("(%s)", e.Calendar))
+	if e.Location != "" {
+		b.WriteString(" @ " + truncate(e.Location, 30))
+	}
+	return b.String()
+}
+
+func startOfLocalDay(t time.Time) time.Time {
+	t = t.In(DisplayLocation())
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, DisplayLocation())
+}
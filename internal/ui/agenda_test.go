@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/fatih/color"
+)
+
+func TestPrintEventsAgenda(t *testing.T) {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2026, 3, day, hour, min, 0, 0, time.Local)
+	}
+	events := []calendar.Event{
+		{Title: "Standup", StartDate: at(17, 9, 0), EndDate: at(17, 9, 30), Calendar: "Work"},
+		{Title: "Design review", StartDate: at(17, 11, 0), EndDate: at(17, 12, 0), Calendar: "Work", Location: "Room 4"},
+		{Title: "Conference", StartDate: at(17, 0, 0), EndDate: at(19, 0, 0), AllDay: true, Calendar: "Work"},
+		{Title: "Night shift", StartDate: at(18, 22, 0), EndDate: at(19, 6, 0), Calendar: "Home"},
+		{Title: "Dentist", StartDate: at(20, 8, 0), EndDate: at(20, 9, 0), Calendar: "Home"},
+	}
+	now := at(17, 9, 10)
+
+	var buf bytes.Buffer
+	printEventsAgenda(events, &buf, now)
+	got := buf.String()
+
+	want := `Today — Tuesday 17 March
+   #3  All day        Conference  (Work)
+▶  #1  09:00–09:30    Standup  (Work)  (now, 20m left)
+       09:30–11:00    free 1h30m
+   #2  11:00–12:00    Design review  (Work) @ Room 4
+
+Tomorrow — Wednesday 18 March
+   #3  All day        Conference  (Work)
+   #4  22:00–…        Night shift  (Home)
+
+Thursday 19 March
+   #4  …–06:00        Night shift  (Home)
+
+Friday 20 March
+   #5  08:00–09:00    Dentist  (Home)
+`
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestPrintEventsAgendaNowMarker(t *testing.T) {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	at := func(hour int) time.Time {
+		return time.Date(2026, 3, 17, hour, 0, 0, 0, time.Local)
+	}
+	events := []calendar.Event{
+		{Title: "Standup", StartDate: at(9), EndDate: at(10), Calendar: "Work"},
+		{Title: "Lunch", StartDate: at(12), EndDate: at(13), Calendar: "Home"},
+	}
+
+	tests := []struct {
+		name  string
+		now   time.Time
+		after string // line the marker follows
+	}{
+		{"before first", at(8), "Today — Tuesday 17 March"},
+		{"in a gap", at(11), "free 2h"},
+		{"after last", at(15), "Lunch  (Home)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			printEventsAgenda(events, &buf, tt.now)
+			lines := strings.Split(buf.String(), "\n")
+			for i, l := range lines {
+				if strings.Contains(l, "── now") {
+					if i == 0 || !strings.Contains(lines[i-1], tt.after) {
+						t.Errorf("now marker follows %q, want %q:\n%s", lines[max(i-1, 0)], tt.after, buf.String())
+					}
+					return
+				}
+			}
+			t.Errorf("no now marker:\n%s", buf.String())
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package ui
+
+import (
+	"hash/fnv"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/fatih/color"
+)
+
+// CalendarColors maps calendar names to the color Calendar.app shows them
+// in, so views can tint events by calendar.
+type CalendarColors map[string]*color.Color
+
+// fallbackPalette is used for calendars without a parsable color.
+var fallbackPalette = []color.Attribute{
+	color.FgHiBlue, color.FgHiGreen, color.FgHiMagenta,
+	color.FgHiCyan, color.FgHiYellow, color.FgHiRed,
+}
+
+// NewCalendarColors builds the color map from calendar.Calendar.Color.
+func NewCalendarColors(calendars []calendar.Calendar) CalendarColors {
+	colors := CalendarColors{}
+	for _, c := range calendars {
+		if col := hexColor(c.Color); col != nil {
+			colors[c.Title] = col
+		}
+	}
+	return colors
+}
+
+// For returns the color for a calendar name. Unknown calendars get a
+// stable color from a small palette so they still stand apart.
+func (c CalendarColors) For(name string) *color.Color {
+	if col, ok := c[name]; ok {
+		return col
+	}
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	return color.New(fallbackPalette[h.Sum32()%uint32(len(fallbackPalette))])
+}
+
+// eventColors tints the calendar column of listings; see
+// SetCalendarColors.
+var eventColors CalendarColors
+
+// SetCalendarColors turns on calendar bullets in event tables, agenda and
+// detail output.
+func SetCalendarColors(c CalendarColors) {
+	eventColors = c
+}
+
+// calendarBullet returns a "● " in the calendar's color, or "" when
+// calendar colors are off or color output is disabled.
+func calendarBullet(name string) string {
+	if eventColors == nil || color.NoColor {
+		return ""
+	}
+	return eventColors.For(name).Sprint("●") + " "
+}
+
+// colorDepth is how many colors the terminal can show.
+type colorDepth int
+
+const (
+	depth16 colorDepth = iota
+	depth256
+	depthTrue
+)
+
+// termDepth is the color depth of the terminal ical is running in.
+var termDepth = detectColorDepth(os.Getenv("COLORTERM"), os.Getenv("TERM"))
+
+// detectColorDepth reads the conventional environment variables:
+// COLORTERM=truecolor (or 24bit) for 24-bit color, a TERM ending in
+// 256color for the xterm palette, and 16 colors otherwise.
+func detectColorDepth(colorterm, term string) colorDepth {
+	switch strings.ToLower(colorterm) {
+	case "truecolor", "24bit":
+		return depthTrue
+	}
+	if strings.Contains(term, "256color") {
+		return depth256
+	}
+	return depth16
+}
+
+// hexColor parses "#RRGGBB" (an alpha suffix is ignored) into a
+// foreground color the terminal can show. Returns nil for anything else.
+func hexColor(s string) *color.Color {
+	r, g, b, ok := parseHex(s)
+	if !ok {
+		return nil
+	}
+	return rgbColor(r, g, b, termDepth)
+}
+
+// parseHex splits "#RRGGBB" or "#RRGGBBAA" into its red, green and blue
+// components.
+func parseHex(s string) (r, g, b int, ok bool) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "#")
+	if len(s) == 8 {
+		s = s[:6]
+	}
+	if len(s) != 6 {
+		return 0, 0, 0, false
+	}
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	return int(v >> 16 & 0xff), int(v >> 8 & 0xff), int(v & 0xff), true
+}
+
+// rgbColor approximates an RGB color at the given depth.
+func rgbColor(r, g, b int, depth colorDepth) *color.Color {
+	switch depth {
+	case depthTrue:
+		return color.RGB(r, g, b)
+	case depth256:
+		return color.New(38, 5, color.Attribute(ansi256(r, g, b)))
+	default:
+		return color.New(ansi16(r, g, b))
+	}
+}
+
+// ansi256 maps an RGB color to the nearest entry of the xterm 256-color
+// palette: the 6x6x6 cube, or the gray ramp for near-grays.
+func ansi256(r, g, b int) int {
+	if max(r, g, b)-min(r, g, b) < 16 {
+		gray := (r + g + b) / 3
+		switch {
+		case gray < 8:
+			return 16
+		case gray > 238:
+			return 231
+		}
+		return 232 + (gray-8)/10
+	}
+	level := func(v int) int {
+		if v < 48 {
+			return 0
+		}
+		if v < 115 {
+			return 1
+		}
+		return (v - 35) / 40
+	}
+	return 16 + 36*level(r) + 6*level(g) + level(b)
+}
+
+// ansi16Palette is the xterm rendering of the 16 basic colors.
+var ansi16Palette = []struct {
+	attr    color.Attribute
+	r, g, b int
+}{
+	{color.FgBlack, 0, 0, 0},
+	{color.FgRed, 205, 0, 0},
+	{color.FgGreen, 0, 205, 0},
+	{color.FgYellow, 205, 205, 0},
+	{color.FgBlue, 0, 0, 238},
+	{color.FgMagenta, 205, 0, 205},
+	{color.FgCyan, 0, 205, 205},
+	{color.FgWhite, 229, 229, 229},
+	{color.FgHiBlack, 127, 127, 127},
+	{color.FgHiRed, 255, 0, 0},
+	{color.FgHiGreen, 0, 255, 0},
+	{color.FgHiYellow, 255, 255, 0},
+	{color.FgHiBlue, 92, 92, 255},
+	{color.FgHiMagenta, 255, 0, 255},
+	{color.FgHiCyan, 0, 255, 255},
+	{color.FgHiWhite, 255, 255, 255},
+}
+
+// ansi16 maps an RGB color to the closest basic terminal color.
+func ansi16(r, g, b int) color.Attribute {
+	best, bestDist := color.FgWhite, -1
+	for _, p := range ansi16Palette {
+		dr, dg, db := r-p.r, g-p.g, b-p.b
+		if d := dr*dr + dg*dg + db*db; bestDist < 0 || d < bestDist {
+			best, bestDist = p.attr, d
+		}
+	}
+	return best
+}
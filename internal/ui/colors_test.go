@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/fatih/color"
+)
+
+func TestDetectColorDepth(t *testing.T) {
+	tests := []struct {
+		colorterm, term string
+		want            colorDepth
+	}{
+		{"truecolor", "xterm-256color", depthTrue},
+		{"24bit", "xterm", depthTrue},
+		{"", "xterm-256color", depth256},
+		{"", "screen-256color", depth256},
+		{"", "xterm", depth16},
+		{"", "", depth16},
+	}
+	for _, tt := range tests {
+		if got := detectColorDepth(tt.colorterm, tt.term); got != tt.want {
+			t.Errorf("detectColorDepth(%q, %q) = %d, want %d", tt.colorterm, tt.term, got, tt.want)
+		}
+	}
+}
+
+func TestRGBColor(t *testing.T) {
+	tests := []struct {
+		name    string
+		r, g, b int
+		depth   colorDepth
+		want    string
+	}{
+		{"truecolor", 0x1b, 0xad, 0xf8, depthTrue, "\x1b[38;2;27;173;248m"},
+		{"256 cube", 0x1b, 0xad, 0xf8, depth256, "\x1b[38;5;39m"},
+		{"256 pure red", 255, 0, 0, depth256, "\x1b[38;5;196m"},
+		{"256 gray", 128, 128, 128, depth256, "\x1b[38;5;244m"},
+		{"16 sky blue to cyan", 0x1b, 0xad, 0xf8, depth16, "\x1b[36m"},
+		{"16 red", 0xff, 0x2d, 0x55, depth16, "\x1b[91m"},
+		{"16 green", 0x34, 0xc7, 0x59, depth16, "\x1b[32m"},
+	}
+
+	color.NoColor = false
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rgbColor(tt.r, tt.g, tt.b, tt.depth).Sprint("x")
+			if !strings.HasPrefix(got, tt.want) {
+				t.Errorf("got %q, want prefix %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalendarBullets(t *testing.T) {
+	defer SetCalendarColors(nil)
+	defer func() { color.NoColor = false }()
+
+	at := time.Date(2026, 3, 17, 9, 0, 0, 0, time.Local)
+	events := []calendar.Event{
+		{ID: "a", Title: "Standup", Calendar: "Work", StartDate: at, EndDate: at.Add(30 * time.Minute)},
+	}
+	render := func() string {
+		var buf bytes.Buffer
+		printEventsTable(events, &buf)
+		printEventDetailTable(&events[0], &buf)
+		printEventsAgenda(events, &buf, at)
+		return buf.String()
+	}
+
+	color.NoColor = false
+	if out := render(); strings.Contains(out, "●") {
+		t.Errorf("bullet shown without calendar colors:\n%s", out)
+	}
+
+	SetCalendarColors(CalendarColors{"Work": rgbColor(0x1b, 0xad, 0xf8, depthTrue)})
+	out := render()
+	if n := strings.Count(out, "\x1b[38;2;27;173;248m●"); n != 3 {
+		t.Errorf("got %d colored bullets, want 3 (table, detail, agenda):\n%s", n, out)
+	}
+
+	color.NoColor = true
+	if out := render(); strings.Contains(out, "●") || strings.Contains(out, "\x1b[") {
+		t.Errorf("colors shown under --no-color:\n%s", out)
+	}
+}
@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/fatih/color"
+)
+
+// conflictMarker flags an event that overlaps another in table and agenda
+// output.
+func conflictMarker() string {
+	return color.HiRedString("⚠")
+}
+
+// blocksTime reports whether an event occupies its slot. All-day events,
+// events marked free and invitations the user declined don't conflict.
+func blocksTime(e calendar.Event) bool {
+	return !e.AllDay &&
+		e.Availability != calendar.AvailabilityFree &&
+		e.SelfStatus != calendar.ParticipantStatusDeclined &&
+		e.Status != calendar.StatusCanceled
+}
+
+// busyInterval is the time an event blocks, including travel time before
+// it.
+func busyInterval(e calendar.Event) (time.Time, time.Time) {
+	return e.StartDate.Add(-e.TravelTime), e.EndDate
+}
+
+// findConflicts returns, for each event, the indexes of the other events
+// it overlaps, in list order.
+func findConflicts(events []calendar.Event) [][]int {
+	conflicts := make([][]int, len(events))
+
+	var order []int
+	for i, e := range events {
+		if blocksTime(e) {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		sa, _ := busyInterval(events[order[a]])
+		sb, _ := busyInterval(events[order[b]])
+		return sa.Before(sb)
+	})
+
+	for a, i := range order {
+		_, endI := busyInterval(events[i])
+		for _, j := range order[a+1:] {
+			startJ, endJ := busyInterval(events[j])
+			if !startJ.Before(endI) {
+				break
+			}
+			if !endJ.After(startJ) {
+				continue
+			}
+			conflicts[i] = append(conflicts[i], j)
+			conflicts[j] = append(conflicts[j], i)
+		}
+	}
+	for _, c := range conflicts {
+		sort.Ints(c)
+	}
+	return conflicts
+}
+
+// conflictIDs maps conflict indexes to event IDs for JSON output.
+func conflictIDs(events []calendar.Event, idx []int) []string {
+	if len(idx) == 0 {
+		return nil
+	}
+	ids := make([]string, len(idx))
+	for i, j := range idx {
+		ids[i] = events[j].ID
+	}
+	return ids
+}
+
+// conflictRows lists 1-based row numbers as "#2 #5".
+func conflictRows(rows []int) string {
+	parts := make([]string, len(rows))
+	for i, r := range rows {
+		parts[i] = fmt.Sprintf("#%d", r)
+	}
+	return strings.Join(parts, " ")
+}
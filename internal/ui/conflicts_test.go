@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/fatih/color"
+)
+
+func TestFindConflicts(t *testing.T) {
+	at := func(hour, min int) time.Time {
+		return time.Date(2026, 3, 17, hour, min, 0, 0, time.Local)
+	}
+	tests := []struct {
+		name   string
+		events []calendar.Event
+		want   [][]int
+	}{
+		{
+			"overlap",
+			[]calendar.Event{
+				{StartDate: at(9, 0), EndDate: at(10, 0)},
+				{StartDate: at(9, 30), EndDate: at(10, 30)},
+				{StartDate: at(10, 30), EndDate: at(11, 0)},
+			},
+			[][]int{{1}, {0}, nil},
+		},
+		{
+			"one long event over two",
+			[]calendar.Event{
+				{StartDate: at(9, 0), EndDate: at(9, 30)},
+				{StartDate: at(10, 0), EndDate: at(10, 30)},
+				{StartDate: at(8, 0), EndDate: at(12, 0)},
+			},
+			[][]int{{2}, {2}, {0, 1}},
+		},
+		{
+			"travel time",
+			[]calendar.Event{
+				{StartDate: at(9, 0), EndDate: at(10, 0)},
+				{StartDate: at(10, 15), EndDate: at(11, 0), TravelTime: 30 * time.Minute},
+			},
+			[][]int{{1}, {0}},
+		},
+		{
+			"free, declined, cancelled and all-day events don't block",
+			[]calendar.Event{
+				{StartDate: at(9, 0), EndDate: at(10, 0)},
+				{StartDate: at(9, 0), EndDate: at(10, 0), Availability: calendar.AvailabilityFree},
+				{StartDate: at(9, 0), EndDate: at(10, 0), SelfStatus: calendar.ParticipantStatusDeclined},
+				{StartDate: at(9, 0), EndDate: at(10, 0), Status: calendar.StatusCanceled},
+				{StartDate: at(0, 0), EndDate: at(0, 0).AddDate(0, 0, 1), AllDay: true},
+			},
+			[][]int{nil, nil, nil, nil, nil},
+		},
+		{
+			"zero-length events",
+			[]calendar.Event{
+				{StartDate: at(9, 0), EndDate: at(10, 0)},
+				{StartDate: at(9, 30), EndDate: at(9, 30)},
+			},
+			[][]int{nil, nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findConflicts(tt.events)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConflictOutput(t *testing.T) {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	at := func(hour, min int) time.Time {
+		return time.Date(2026, 3, 17, hour, min, 0, 0, time.Local)
+	}
+	events := []calendar.Event{
+		{ID: "a", Title: "Standup", StartDate: at(9, 0), EndDate: at(9, 30), Calendar: "Work"},
+		{ID: "b", Title: "Dentist", StartDate: at(9, 15), EndDate: at(10, 0), Calendar: "Home"},
+		{ID: "c", Title: "Lunch", StartDate: at(12, 0), EndDate: at(13, 0), Calendar: "Home"},
+	}
+
+	var buf bytes.Buffer
+	printEventsJSON(events, &buf)
+	var out []map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatal(err)
+	}
+	if got := out[0]["conflicts"]; !reflect.DeepEqual(got, []any{"b"}) {
+		t.Errorf("event a conflicts = %v, want [b]", got)
+	}
+	if _, ok := out[2]["conflicts"]; ok {
+		t.Errorf("event c has conflicts %v, want none", out[2]["conflicts"])
+	}
+
+	buf.Reset()
+	printEventsTable(events, &buf)
+	for _, line := range strings.Split(buf.String(), "\n") {
+		for title, want := range map[string]bool{"Standup": true, "Dentist": true, "Lunch": false} {
+			if strings.Contains(line, title) && strings.Contains(line, "⚠") != want {
+				t.Errorf("%s marked = %v, want %v: %q", title, !want, want, line)
+			}
+		}
+	}
+
+	buf.Reset()
+	printEventsFieldsPlain(events, mustFields("index,title,conflicts"), &buf)
+	want := "1\tStandup\t#2\n2\tDentist\t#1\n3\tLunch\t\n"
+	if buf.String() != want {
+		t.Errorf("conflicts field:\ngot  %q\nwant %q", buf.String(), want)
+	}
+}
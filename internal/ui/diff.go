@@ -0,0 +1,132 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/ical/internal/diff"
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+)
+
+type diffJSON struct {
+	Added     []eventJSON    `json:"added"`
+	Removed   []eventJSON    `json:"removed"`
+	Modified  []modifiedJSON `json:"modified"`
+	Unchanged int            `json:"unchanged"`
+}
+
+type modifiedJSON struct {
+	Event   eventJSON     `json:"event"`
+	Changes []diff.Change `json:"changes"`
+}
+
+// PrintDiff prints a comparison of two event sets as JSON or as a table
+// of added, removed and modified events under a title naming what was
+// compared.
+func PrintDiff(r *diff.Result, title, format string) {
+	if format == "json" {
+		printDiffJSON(r, os.Stdout)
+		return
+	}
+	printDiffTable(r, title, os.Stdout)
+}
+
+func printDiffJSON(r *diff.Result, w io.Writer) {
+	out := diffJSON{
+		Added:     []eventJSON{},
+		Removed:   []eventJSON{},
+		Modified:  []modifiedJSON{},
+		Unchanged: r.Unchanged,
+	}
+	for _, e := range r.Added {
+		out.Added = append(out.Added, toEventJSON(e))
+	}
+	for _, e := range r.Removed {
+		out.Removed = append(out.Removed, toEventJSON(e))
+	}
+	for _, m := range r.Modified {
+		out.Modified = append(out.Modified, modifiedJSON{Event: toEventJSON(m.New), Changes: m.Changes})
+	}
+	data, _ := json.MarshalIndent(out, "", "  ")
+	fmt.Fprintln(w, string(data))
+}
+
+func printDiffTable(r *diff.Result, title string, w io.Writer) {
+	color.New(color.Bold).Fprintf(w, "%s\n\n", title)
+	if r.Empty() {
+		fmt.Fprintf(w, "No changes (%d events unchanged).\n", r.Unchanged)
+		return
+	}
+
+	green := color.New(color.FgGreen)
+	red := color.New(color.FgRed)
+	yellow := color.New(color.FgYellow)
+
+	t := tablewriter.NewTable(w,
+		tablewriter.WithConfig(tablewriter.Config{
+			Header: tw.CellConfig{Formatting: tw.CellFormatting{Alignment: tw.AlignCenter}},
+			Row:    tw.CellConfig{Formatting: tw.CellFormatting{Alignment: tw.AlignLeft}},
+		}),
+	)
+	t.Header([]string{"", "Date", "Time", "Title", "Calendar", "Changes"})
+
+	row := func(mark string, e calendar.Event, changes string) {
+		start, end := eventTimes(e)
+		t.Append([]string{mark, locale.Date(start, false), locale.TimeRange(start, end, e.AllDay),
+			e.Title, calendarBullet(e.Calendar) + e.Calendar, changes})
+	}
+	for _, e := range r.Added {
+		row(green.Sprint("+"), e, green.Sprint("added"))
+	}
+	for _, e := range r.Removed {
+		row(red.Sprint("-"), e, red.Sprint("removed"))
+	}
+	for _, m := range r.Modified {
+		var lines []string
+		for _, c := range m.Changes {
+			lines = append(lines, diffChangeLine(c, m))
+		}
+		row(yellow.Sprint("~"), m.New, strings.Join(lines, "\n"))
+	}
+	t.Render()
+
+	fmt.Fprintf(w, "%d added, %d removed, %d modified, %d unchanged\n",
+		len(r.Added), len(r.Removed), len(r.Modified), r.Unchanged)
+}
+
+// diffChangeLine describes one change for the table: "location: Room A →
+// Room B", "attendee added: Ana <ana@example.com>".
+func diffChangeLine(c diff.Change, m diff.Modified) string {
+	switch c.Field {
+	case "time":
+		return "time: " + diffSpan(m.Old) + " → " + diffSpan(m.New)
+	case "notes":
+		return "notes changed"
+	case "all_day":
+		return "all day: " + c.Old + " → " + c.New
+	case "attendee":
+		if c.New != "" {
+			return "attendee added: " + c.New
+		}
+		return "attendee removed: " + c.Old
+	}
+	return fmt.Sprintf("%s: %s → %s", c.Field, diffValue(c.Old), diffValue(c.New))
+}
+
+func diffSpan(e calendar.Event) string {
+	start, end := eventTimes(e)
+	return locale.Date(start, false) + " " + locale.TimeRange(start, end, e.AllDay)
+}
+
+func diffValue(s string) string {
+	if s == "" {
+		return "(none)"
+	}
+	return truncate(s, 40)
+}
@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/ical/internal/diff"
+	"github.com/fatih/color"
+)
+
+func testDiff() *diff.Result {
+	at := func(day, hour int) time.Time { return time.Date(2026, 3, day, hour, 0, 0, 0, time.Local) }
+	old := calendar.Event{ID: "m", Title: "Review", Calendar: "Team", StartDate: at(3, 14), EndDate: at(3, 15), Location: "Room 1"}
+	cur := old
+	cur.StartDate, cur.EndDate, cur.Location = at(4, 10), at(4, 11), "Room 2"
+	return &diff.Result{
+		Added:   []calendar.Event{{ID: "n", Title: "Retro", Calendar: "Team", StartDate: at(6, 15), EndDate: at(6, 16)}},
+		Removed: []calendar.Event{{ID: "g", Title: "Offsite prep", Calendar: "Team", StartDate: at(5, 11), EndDate: at(5, 12)}},
+		Modified: []diff.Modified{{Old: old, New: cur, Changes: []diff.Change{
+			{Field: "time"},
+			{Field: "location", Old: "Room 1", New: "Room 2"},
+			{Field: "attendee", New: "Bob <bob@example.com>"},
+			{Field: "attendee", Old: "Ana"},
+		}}},
+		Unchanged: 7,
+	}
+}
+
+func TestPrintDiffTable(t *testing.T) {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	var buf bytes.Buffer
+	printDiffTable(testDiff(), "team.json → calendar", &buf)
+	out := buf.String()
+
+	for _, want := range []string{
+		"team.json → calendar",
+		"Retro", "added",
+		"Offsite prep", "removed",
+		"time: Tue 03 Mar 14:00 - 15:00 → Wed 04 Mar 10:00 - 11:00",
+		"location: Room 1 → Room 2",
+		"attendee added: Bob <bob@example.com>",
+		"attendee removed: Ana",
+		"1 added, 1 removed, 1 modified, 7 unchanged",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	buf.Reset()
+	printDiffTable(&diff.Result{Unchanged: 3}, "a → b", &buf)
+	if !strings.Contains(buf.String(), "No changes (3 events unchanged).") {
+		t.Errorf("empty diff = %q", buf.String())
+	}
+}
+
+func TestPrintDiffJSON(t *testing.T) {
+	var buf bytes.Buffer
+	printDiffJSON(testDiff(), &buf)
+
+	var got struct {
+		Added    []map[string]any `json:"added"`
+		Removed  []map[string]any `json:"removed"`
+		Modified []struct {
+			Event   map[string]any `json:"event"`
+			Changes []diff.Change  `json:"changes"`
+		} `json:"modified"`
+		Unchanged int `json:"unchanged"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if len(got.Added) != 1 || got.Added[0]["title"] != "Retro" || len(got.Removed) != 1 || got.Unchanged != 7 {
+		t.Errorf("got %+v", got)
+	}
+	if len(got.Modified) != 1 || got.Modified[0].Event["location"] != "Room 2" || len(got.Modified[0].Changes) != 4 {
+		t.Errorf("modified = %+v", got.Modified)
+	}
+
+	buf.Reset()
+	printDiffJSON(&diff.Result{}, &buf)
+	if !strings.Contains(buf.String(), `"added": []`) {
+		t.Errorf("empty lists should be [], got %s", buf.String())
+	}
+}
@@ -0,0 +1,495 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/go-eventkit/dateparser"
+	"github.com/fatih/color"
+	runewidth "github.com/mattn/go-runewidth"
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+)
+
+// fieldRow is one event as seen by a field: its row number, the event, its
+// start and end in the local zone, whether dates need a year, and the row
+// numbers and IDs of the events it overlaps.
+type fieldRow struct {
+	index       int
+	event       calendar.Event
+	start       time.Time
+	end         time.Time
+	showYear    bool
+	conflicts   []int
+	conflictIDs []string
+}
+
+// Field is a selectable event column for --fields.
+type Field struct {
+	Name   string
+	Header string
+	// JSONKey matches the key in the full JSON output where one exists.
+	JSONKey string
+	text    func(r fieldRow) string
+	json    func(r fieldRow) any
+	// display replaces text in the table, where a locale format reads
+	// better than the ISO one scripts rely on.
+	display func(r fieldRow) string
+}
+
+// eventFields is the registry of columns, in the order `--fields help`
+// lists them.
+var eventFields = []Field{
+	{Name: "index", Header: "#", JSONKey: "index",
+		text: func(r fieldRow) string { return strconv.Itoa(r.index) },
+		json: func(r fieldRow) any { return r.index }},
+	{Name: "id", Header: "ID", JSONKey: "id",
+		text: func(r fieldRow) string { return r.event.ID }},
+	{Name: "date", Header: "Date", JSONKey: "date",
+		text: func(r fieldRow) string { return eventDateLabel(r.start, r.showYear) }},
+	{Name: "time", Header: "Time", JSONKey: "time",
+		text: func(r fieldRow) string { return locale.TimeRange(r.start, r.end, r.event.AllDay) }},
+	{Name: "start", Header: "Start", JSONKey: "start_date",
+		text:    func(r fieldRow) string { return fieldTime(r.start, r.event.AllDay) },
+		json:    func(r fieldRow) any { return r.event.StartDate },
+		display: func(r fieldRow) string { return localeFieldTime(r.start, r.event.AllDay, r.showYear) }},
+	{Name: "end", Header: "End", JSONKey: "end_date",
+		text:    func(r fieldRow) string { return fieldTime(r.end, r.event.AllDay) },
+		json:    func(r fieldRow) any { return r.event.EndDate },
+		display: func(r fieldRow) string { return localeFieldTime(r.end, r.event.AllDay, r.showYear) }},
+	{Name: "title", Header: "Title", JSONKey: "title",
+		text: func(r fieldRow) string { return r.event.Title }},
+	{Name: "calendar", Header: "Calendar", JSONKey: "calendar",
+		text: func(r fieldRow) string { return r.event.Calendar }},
+	{Name: "calendar_id", Header: "Calendar ID", JSONKey: "calendar_id",
+		text: func(r fieldRow) string { return r.event.CalendarID }},
+	{Name: "location", Header: "Location", JSONKey: "location",
+		text: func(r fieldRow) string { return r.event.Location }},
+	{Name: "duration", Header: "Duration", JSONKey: "duration",
+		text: func(r fieldRow) string { return dateparser.FormatDuration(r.start, r.end, r.event.AllDay) }},
+	{Name: "all_day", Header: "All Day", JSONKey: "all_day",
+		text: func(r fieldRow) string { return yesNo(r.event.AllDay) },
+		json: func(r fieldRow) any { return r.event.AllDay }},
+	{Name: "recurring", Header: "Recurring", JSONKey: "recurring",
+		text: func(r fieldRow) string { return yesNo(r.event.Recurring) },
+		json: func(r fieldRow) any { return r.event.Recurring }},
+	{Name: "status", Header: "Status", JSONKey: "status",
+		text: func(r fieldRow) string { return r.event.Status.String() }},
+	{Name: "availability", Header: "Availability", JSONKey: "availability",
+		text: func(r fieldRow) string { return r.event.Availability.String() }},
+	{Name: "self_status", Header: "My RSVP", JSONKey: "self_status",
+		text: func(r fieldRow) string { return selfStatusJSON(r.event.SelfStatus) }},
+	{Name: "organizer", Header: "Organizer", JSONKey: "organizer",
+		text: func(r fieldRow) string { return r.event.Organizer }},
+	{Name: "attendees", Header: "Attendees", JSONKey: "attendees",
+		text: func(r fieldRow) string { return attendeeNames(r.event.Attendees) },
+		json: func(r fieldRow) any { return r.event.Attendees }},
+	{Name: "conference_url", Header: "Conference", JSONKey: "conference_url",
+		text: func(r fieldRow) string { return r.event.ConferenceURL }},
+	{Name: "url", Header: "URL", JSONKey: "url",
+		text: func(r fieldRow) string { return r.event.URL }},
+	{Name: "notes", Header: "Notes", JSONKey: "notes",
+		text: func(r fieldRow) string { return r.event.Notes }},
+	{Name: "travel_time", Header: "Travel", JSONKey: "travel_time",
+		text: func(r fieldRow) string { return travelTimeJSON(r.event.TravelTime) }},
+	{Name: "timezone", Header: "Time Zone", JSONKey: "timezone",
+		text: func(r fieldRow) string { return r.event.TimeZone }},
+	{Name: "conflicts", Header: "Conflicts", JSONKey: "conflicts",
+		text: func(r fieldRow) string { return conflictRows(r.conflicts) },
+		json: func(r fieldRow) any {
+			if r.conflictIDs == nil {
+				return []string{}
+			}
+			return r.conflictIDs
+		}},
+}
+
+// fieldAliases maps alternate spellings to registry names.
+var fieldAliases = map[string]string{
+	"#":            "index",
+	"num":          "index",
+	"start_date":   "start",
+	"end_date":     "end",
+	"conference":   "conference_url",
+	"rsvp":         "self_status",
+	"allday":       "all_day",
+	"tz":           "timezone",
+	"calendarid":   "calendar_id",
+	"selfstatus":   "self_status",
+	"travel":       "travel_time",
+	"participants": "attendees",
+}
+
+// FieldPresets are the built-in --fields presets. Presets from the config
+// file (fields.<name> = ...) are added on top and may override these.
+var FieldPresets = map[string]string{
+	"default": "index,date,time,title,calendar,location,duration",
+	"compact": "index,date,time,title,calendar",
+	"report":  "date,time,title,calendar,organizer,self_status,attendees",
+	"meeting": "index,date,time,title,conference_url,self_status",
+}
+
+// defaultFields is the column set used by the table and CSV output when
+// --fields is not given.
+var defaultFields = mustFields(FieldPresets["default"])
+
+// selectedFields holds the --fields choice for this run; nil means the
+// stock layout for each output format.
+var selectedFields []Field
+
+// SetEventFields selects the columns for event listings.
+func SetEventFields(fields []Field) {
+	selectedFields = fields
+}
+
+// FieldNames returns every selectable field name.
+func FieldNames() []string {
+	names := make([]string, len(eventFields))
+	for i, f := range eventFields {
+		names[i] = f.Name
+	}
+	return names
+}
+
+// ParseFields resolves a --fields value: either a preset name or a
+// comma-separated list of field names. presets overlays FieldPresets.
+func ParseFields(spec string, presets map[string]string) ([]Field, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil, fmt.Errorf("no fields given")
+	}
+	if !strings.Contains(spec, ",") {
+		name := strings.ToLower(spec)
+		if p, ok := presets[name]; ok {
+			spec = p
+		} else if p, ok := FieldPresets[name]; ok {
+			spec = p
+		}
+	}
+
+	var fields []Field
+	seen := map[string]bool{}
+	for _, part := range strings.Split(spec, ",") {
+		name := strings.ToLower(strings.TrimSpace(part))
+		if name == "" {
+			continue
+		}
+		f, ok := lookupField(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q (available: %s; presets: %s)",
+				part, strings.Join(FieldNames(), ", "), strings.Join(presetNames(presets), ", "))
+		}
+		if seen[f.Name] {
+			continue
+		}
+		seen[f.Name] = true
+		fields = append(fields, f)
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no fields given")
+	}
+	return fields, nil
+}
+
+func lookupField(name string) (Field, bool) {
+	name = strings.ReplaceAll(name, "-", "_")
+	if alias, ok := fieldAliases[name]; ok {
+		name = alias
+	}
+	for _, f := range eventFields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+func mustFields(spec string) []Field {
+	fields, err := ParseFields(spec, nil)
+	if err != nil {
+		panic(err)
+	}
+	return fields
+}
+
+func presetNames(extra map[string]string) []string {
+	set := map[string]bool{}
+	for name := range FieldPresets {
+		set[name] = true
+	}
+	for name := range extra {
+		set[name] = true
+	}
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func fieldRows(events []calendar.Event) []fieldRow {
+	showYear := eventsSpanMultipleYears(events)
+	conflicts := findConflicts(events)
+	rows := make([]fieldRow, len(events))
+	for i, e := range events {
+		rows[i] = fieldRow{
+			index:       i + 1,
+			event:       e,
+			start:       DisplayTime(e.StartDate, e.AllDay),
+			end:         DisplayTime(e.EndDate, e.AllDay),
+			showYear:    showYear,
+			conflictIDs: conflictIDs(events, conflicts[i]),
+		}
+		for _, j := range conflicts[i] {
+			rows[i].conflicts = append(rows[i].conflicts, j+1)
+		}
+	}
+	return rows
+}
+
+// Events — selected fields
+
+func printEventsFieldsTable(events []calendar.Event, fields []Field, w io.Writer) {
+	if len(events) == 0 {
+		fmt.Fprintln(w, "No events found.")
+		return
+	}
+
+	headers := make([]any, len(fields))
+	merge := tw.Mapper[int, bool]{}
+	for i, f := range fields {
+		headers[i] = f.Header
+		if f.Name == "date" {
+			merge[i] = true
+		}
+	}
+
+	t := tablewriter.NewTable(w,
+		tablewriter.WithConfig(tablewriter.Config{
+			Header: tw.CellConfig{Formatting: tw.CellFormatting{Alignment: tw.AlignCenter}},
+			Row: tw.CellConfig{
+				Formatting: tw.CellFormatting{Alignment: tw.AlignLeft},
+				Merging:    tw.CellMerging{Mode: tw.MergeVertical, ByColumnIndex: merge},
+			},
+		}),
+	)
+	t.Header(headers...)
+
+	rows := fieldRows(events)
+	natural := make([]int, len(fields))
+	for i, f := range fields {
+		natural[i] = runewidth.StringWidth(f.Header)
+		for _, r := range rows {
+			natural[i] = max(natural[i], runewidth.StringWidth(tableText(f, r))+tableMarkerWidth(f, r))
+		}
+	}
+	width := detectedWidth()
+	limits := planFieldsTable(fields, natural, width)
+
+	for _, r := range rows {
+		cells := make([]any, len(fields))
+		for i, f := range fields {
+			limit := limits[i]
+			if limit > 0 && width > 0 {
+				// The markers were counted in the column's width.
+				limit = max(limit-tableMarkerWidth(f, r), 1)
+			}
+			cells[i] = tableCell(f, r, limit)
+		}
+		t.Append(cells...)
+	}
+
+	t.Render()
+}
+
+// fieldTableWidths are the narrowest a free-text --fields column gets
+// when fitting the terminal, and its cap when piped, as for the stock
+// table's title and location.
+func fieldTableWidths(f Field) (least, piped int, flexible bool) {
+	switch f.Name {
+	case "title":
+		return minTitleWidth, pipedTitleWidth, true
+	case "location", "organizer", "attendees", "notes", "url", "conference_url":
+		return minLocationWidth, pipedLocationWidth, true
+	}
+	return 0, 0, false
+}
+
+// planFieldsTable returns the width each --fields column is cut to (0 =
+// uncut). Like planTable, the other columns keep their width and the
+// free-text ones share what is left in proportion to their content, down
+// to their minimum; the chosen columns are never dropped. A width of 0
+// keeps the piped caps.
+func planFieldsTable(fields []Field, natural []int, width int) []int {
+	limits := make([]int, len(fields))
+	avail, need := width-1, 0
+	for i, f := range fields {
+		_, piped, flexible := fieldTableWidths(f)
+		if width <= 0 {
+			limits[i] = piped
+			continue
+		}
+		avail -= 3
+		if flexible {
+			need += natural[i]
+		} else {
+			avail -= natural[i]
+		}
+	}
+	if width <= 0 || avail >= need {
+		return limits
+	}
+	for i, f := range fields {
+		if least, _, flexible := fieldTableWidths(f); flexible {
+			limits[i] = max(avail*natural[i]/need, min(natural[i], least))
+		}
+	}
+	return limits
+}
+
+// tableText is a field's table text before truncation and highlighting.
+func tableText(f Field, r fieldRow) string {
+	if f.display != nil {
+		return f.display(r)
+	}
+	return strings.ReplaceAll(f.text(r), "\n", " ")
+}
+
+// tableMarkerWidth is the width tableCell adds around a field's text.
+func tableMarkerWidth(f Field, r fieldRow) int {
+	switch {
+	case f.Name == "title" && r.event.Recurring:
+		return 2
+	case f.Name == "calendar" && calendarBullet(r.event.Calendar) != "":
+		return 2
+	}
+	return 0
+}
+
+// tableCell renders a field for the table, cut to limit (0 = uncut) and
+// with the same highlighting as the stock layout.
+func tableCell(f Field, r fieldRow, limit int) string {
+	v := tableText(f, r)
+	if limit > 0 {
+		v = truncate(v, limit)
+	}
+	switch f.Name {
+	case "title":
+		if r.event.AllDay {
+			v = color.HiYellowString(v)
+		}
+		if r.event.Recurring {
+			v = v + " " + color.HiCyanString("↻")
+		}
+	case "calendar":
+		v = calendarBullet(v) + v
+	}
+	return v
+}
+
+func printEventsFieldsPlain(events []calendar.Event, fields []Field, w io.Writer) {
+	for _, r := range fieldRows(events) {
+		cells := make([]string, len(fields))
+		for i, f := range fields {
+			cells[i] = strings.ReplaceAll(f.text(r), "\n", " ")
+		}
+		fmt.Fprintln(w, strings.Join(cells, "\t"))
+	}
+}
+
+func printEventsCSV(events []calendar.Event, fields []Field, w io.Writer) {
+	cw := csv.NewWriter(w)
+	header := make([]string, len(fields))
+	for i, f := range fields {
+		header[i] = f.Name
+	}
+	_ = cw.Write(header)
+	for _, r := range fieldRows(events) {
+		record := make([]string, len(fields))
+		for i, f := range fields {
+			record[i] = f.text(r)
+		}
+		_ = cw.Write(record)
+	}
+	cw.Flush()
+}
+
+// printEventsFieldsJSON writes one object per event holding only the
+// selected fields, in the order they were given.
+func printEventsFieldsJSON(events []calendar.Event, fields []Field, w io.Writer) {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, r := range fieldRows(events) {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('{')
+		for j, f := range fields {
+			if j > 0 {
+				buf.WriteByte(',')
+			}
+			key, _ := json.Marshal(f.JSONKey)
+			var v any
+			if f.json != nil {
+				v = f.json(r)
+			} else {
+				v = f.text(r)
+			}
+			val, _ := json.Marshal(v)
+			buf.Write(key)
+			buf.WriteByte(':')
+			buf.Write(val)
+		}
+		buf.WriteByte('}')
+	}
+	buf.WriteByte(']')
+	fmt.Fprintln(w, buf.String())
+}
+
+// fieldTime formats a start or end for text output: the date alone for
+// all-day events, otherwise date and time in the local zone.
+func fieldTime(t time.Time, allDay bool) string {
+	if allDay {
+		return t.Format("2006-01-02")
+	}
+	return t.Format("2006-01-02 15:04")
+}
+
+// localeFieldTime formats a start or end for the table in the display
+// locale, adding the year when the events span several years.
+func localeFieldTime(t time.Time, allDay, year bool) string {
+	if allDay {
+		return locale.Date(t, year)
+	}
+	if year {
+		return locale.Date(t, true) + " " + locale.Clock(t)
+	}
+	return locale.DateTime(t)
+}
+
+func attendeeNames(attendees []calendar.Attendee) string {
+	names := make([]string, len(attendees))
+	for i, a := range attendees {
+		names[i] = a.Name
+		if names[i] == "" {
+			names[i] = a.Email
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
@@ -0,0 +1,242 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/fatih/color"
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+func fieldNames(fields []Field) string {
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = f.Name
+	}
+	return strings.Join(names, ",")
+}
+
+func TestParseFields(t *testing.T) {
+	presets := map[string]string{
+		"mine":    "title,organizer",
+		"compact": "title",
+	}
+
+	tests := []struct {
+		spec    string
+		want    string
+		wantErr bool
+	}{
+		{spec: "title,start", want: "title,start"},
+		{spec: "start,title", want: "start,title"},
+		{spec: " Title , START_DATE ", want: "title,start"},
+		{spec: "title,rsvp,conference", want: "title,self_status,conference_url"},
+		{spec: "conference-url", want: "conference_url"},
+		{spec: "title,title", want: "title"},
+		{spec: "report", want: "date,time,title,calendar,organizer,self_status,attendees"},
+		{spec: "mine", want: "title,organizer"},
+		{spec: "compact", want: "title"},
+		{spec: "title", want: "title"},
+		{spec: "title,bogus", wantErr: true},
+		{spec: "bogus", wantErr: true},
+		{spec: "", wantErr: true},
+		{spec: ",", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			fields, err := ParseFields(tt.spec, presets)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseFields(%q) error = %v, wantErr %v", tt.spec, err, tt.wantErr)
+			}
+			if err == nil && fieldNames(fields) != tt.want {
+				t.Errorf("got %s, want %s", fieldNames(fields), tt.want)
+			}
+		})
+	}
+}
+
+func fieldsTestEvents() []calendar.Event {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local)
+	return []calendar.Event{
+		{
+			Title:      "Design review",
+			StartDate:  start,
+			EndDate:    start.Add(time.Hour),
+			Calendar:   "Work",
+			Organizer:  "Ana",
+			SelfStatus: calendar.ParticipantStatusAccepted,
+			Attendees: []calendar.Attendee{
+				{Name: "Ana", Email: "ana@example.com"},
+				{Email: "bo@example.com"},
+			},
+		},
+		{
+			Title:     "Holiday, \"office closed\"",
+			StartDate: start.AddDate(0, 0, 1),
+			EndDate:   start.AddDate(0, 0, 2),
+			AllDay:    true,
+			Calendar:  "Home",
+		},
+	}
+}
+
+func TestPrintEventsCSV(t *testing.T) {
+	fields, _ := ParseFields("index,start,title,attendees,all_day", nil)
+	var buf bytes.Buffer
+	printEventsCSV(fieldsTestEvents(), fields, &buf)
+
+	want := "index,start,title,attendees,all_day\n" +
+		"1,2026-03-02 09:00,Design review,\"Ana, bo@example.com\",no\n" +
+		"2,2026-03-03,\"Holiday, \"\"office closed\"\"\",,yes\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestPrintEventsFieldsPlain(t *testing.T) {
+	fields, _ := ParseFields("title,calendar,self_status", nil)
+	var buf bytes.Buffer
+	printEventsFieldsPlain(fieldsTestEvents(), fields, &buf)
+
+	want := "Design review\tWork\taccepted\n" +
+		"Holiday, \"office closed\"\tHome\t\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestPrintEventsFieldsJSON(t *testing.T) {
+	fields, _ := ParseFields("title,start,index,attendees", nil)
+	var buf bytes.Buffer
+	printEventsFieldsJSON(fieldsTestEvents(), fields, &buf)
+
+	// Keys keep the requested order.
+	out := buf.String()
+	if !strings.HasPrefix(out, `[{"title":"Design review","start_date":`) {
+		t.Errorf("unexpected key order: %s", out)
+	}
+
+	var got []map[string]any
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d objects, want 2", len(got))
+	}
+	if len(got[0]) != 4 {
+		t.Errorf("object has %d keys, want 4: %v", len(got[0]), got[0])
+	}
+	if got[1]["index"] != float64(2) {
+		t.Errorf("index = %v, want 2", got[1]["index"])
+	}
+	if attendees, ok := got[0]["attendees"].([]any); !ok || len(attendees) != 2 {
+		t.Errorf("attendees = %v, want a list of 2", got[0]["attendees"])
+	}
+}
+
+func TestPrintEventsFieldsTable(t *testing.T) {
+	fields, _ := ParseFields("title,organizer", nil)
+	var buf bytes.Buffer
+	printEventsFieldsTable(fieldsTestEvents(), fields, &buf)
+
+	out := buf.String()
+	for _, want := range []string{"TITLE", "ORGANIZER", "Design review", "Ana"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("table missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "LOCATION") {
+		t.Errorf("table has an unselected column:\n%s", out)
+	}
+}
+
+func TestPlanFieldsTable(t *testing.T) {
+	fields := mustFields("index,time,title,location")
+	// index and time take 1+11 columns plus 13 for borders and padding,
+	// leaving width-25 for title and location.
+	natural := []int{1, 11, 60, 30}
+
+	tests := []struct {
+		name  string
+		width int
+		want  []int
+	}{
+		{"piped keeps the caps", 0, []int{0, 0, 40, 25}},
+		{"room for everything", 120, []int{0, 0, 0, 0}},
+		{"shared in proportion", 85, []int{0, 0, 40, 20}},
+		{"floored at the minimum", 40, []int{0, 0, 20, 12}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := planFieldsTable(fields, natural, tt.width); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("limits = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrintEventsFieldsTableWidth(t *testing.T) {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+	defer SetWidth(0)
+
+	at := time.Date(2026, 3, 17, 9, 0, 0, 0, time.Local)
+	title := strings.Repeat("Quarterly planning ", 4) + "review"
+	events := []calendar.Event{
+		{Title: title, Calendar: "Work", Location: "Conference Room B, Building 4", StartDate: at, EndDate: at.Add(time.Hour), Recurring: true},
+	}
+	fields := mustFields("index,time,title,location")
+
+	for _, width := range []int{200, 100, 70} {
+		SetWidth(width)
+		var buf bytes.Buffer
+		printEventsFieldsTable(events, fields, &buf)
+		out := buf.String()
+		for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+			if w := runewidth.StringWidth(line); w > width {
+				t.Errorf("width %d: line is %d wide: %q", width, w, line)
+			}
+		}
+		if width == 200 && !strings.Contains(out, title+" ↻") {
+			t.Errorf("width 200: full title not shown:\n%s", out)
+		}
+	}
+}
+
+func TestLocaleFieldTime(t *testing.T) {
+	SetLocale(Locale{Clock12: true, MonthFirst: true, WeekStart: time.Sunday, Language: "en"})
+	defer SetLocale(DefaultLocale())
+
+	at := time.Date(2026, 3, 17, 15, 4, 0, 0, time.Local)
+	tests := []struct {
+		allDay, year bool
+		want         string
+	}{
+		{false, false, "Tue Mar 17 3:04pm"},
+		{false, true, "Tue Mar 17 2026 3:04pm"},
+		{true, false, "Tue Mar 17"},
+		{true, true, "Tue Mar 17 2026"},
+	}
+	for _, tt := range tests {
+		if got := localeFieldTime(at, tt.allDay, tt.year); got != tt.want {
+			t.Errorf("localeFieldTime(allDay=%v, year=%v) = %q, want %q", tt.allDay, tt.year, got, tt.want)
+		}
+	}
+}
+
+func TestFieldsStartTableOnly(t *testing.T) {
+	start := mustFields("start")[0]
+	r := fieldRows(fieldsTestEvents())[0]
+	if got, want := start.text(r), "2026-03-02 09:00"; got != want {
+		t.Errorf("text = %q, want %q", got, want)
+	}
+	if got, want := tableText(start, r), "Mon 02 Mar 09:00"; got != want {
+		t.Errorf("table = %q, want %q", got, want)
+	}
+}
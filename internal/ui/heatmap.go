@@ -0,0 +1,199 @@
+package ui
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/BRO3886/ical/internal/stats"
+	"github.com/fatih/color"
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+// heatmapShades are the cell glyphs from free to fully booked.
+var heatmapShades = []string{"·", "░", "▒", "▓", "█"}
+
+// heatmapCell is the width of one hour column in the terminal grid.
+const heatmapCell = 3
+
+// heatmapLevel picks the shade for a booked share: 0 for free, then one
+// level per quarter.
+func heatmapLevel(v float64) int {
+	if v <= 0 {
+		return 0
+	}
+	return min(1+int(v*4), len(heatmapShades)-1)
+}
+
+// heatmapHours is the hour window shown: the working day, widened to cover
+// every booked hour.
+func heatmapHours(h *stats.Heatmap) (first, last int) {
+	first, last = weekFirstHour, weekLastHour
+	for _, row := range h.Booked {
+		for hr, v := range row {
+			if v > 0 {
+				first = min(first, hr)
+				last = max(last, hr+1)
+			}
+		}
+	}
+	return first, last
+}
+
+// heatmapDays lists the weekdays starting from weekStart.
+func heatmapDays(weekStart time.Weekday) []time.Weekday {
+	days := make([]time.Weekday, 7)
+	for i := range days {
+		days[i] = (weekStart + time.Weekday(i)) % 7
+	}
+	return days
+}
+
+// heatmapHourLabel labels an hour column: "09" or, on a 12-hour clock,
+// "9a".
+func heatmapHourLabel(hr int) string {
+	if !locale.Clock12 {
+		return fmt.Sprintf("%02d", hr)
+	}
+	suffix := "a"
+	if hr >= 12 {
+		suffix = "p"
+	}
+	return fmt.Sprintf("%d%s", (hr+11)%12+1, suffix)
+}
+
+// RenderHeatmap draws the heatmap as a weekday by hour grid of shaded
+// blocks, with each day's booked share at the end of its row.
+func RenderHeatmap(w io.Writer, h *stats.Heatmap, weekStart time.Weekday) {
+	first, last := heatmapHours(h)
+	days := heatmapDays(weekStart)
+
+	labelWidth := 0
+	for _, d := range days {
+		labelWidth = max(labelWidth, runewidth.StringWidth(locale.Weekday(d, true)))
+	}
+	bold := color.New(color.Bold)
+	heat := []*color.Color{
+		color.New(color.Faint), color.New(color.FgGreen), color.New(color.FgYellow),
+		color.New(color.FgHiRed), color.New(color.FgRed),
+	}
+
+	bold.Fprintf(w, "%s – %s\n\n", locale.Date(h.From, true), locale.Date(h.To.Add(-time.Nanosecond), true))
+
+	var header strings.Builder
+	header.WriteString(strings.Repeat(" ", labelWidth+1))
+	for hr := first; hr < last; hr++ {
+		header.WriteString(runewidth.FillRight(heatmapHourLabel(hr), heatmapCell))
+	}
+	fmt.Fprintln(w, strings.TrimRight(header.String(), " "))
+
+	for _, d := range days {
+		var row strings.Builder
+		row.WriteString(runewidth.FillRight(locale.Weekday(d, true), labelWidth+1))
+		var sum float64
+		for hr := first; hr < last; hr++ {
+			v := h.Booked[d][hr]
+			sum += v
+			level := heatmapLevel(v)
+			cell := strings.Repeat(heatmapShades[level], heatmapCell-1) + " "
+			if level == 0 {
+				cell = " " + heatmapShades[0] + " "
+			}
+			row.WriteString(heat[level].Sprint(cell))
+		}
+		fmt.Fprintf(w, "%s %3.0f%%\n", row.String(), sum/float64(last-first)*100)
+	}
+
+	fmt.Fprintln(w)
+	legend := []string{"· free", "░ <25%", "▒ <50%", "▓ <75%", "█ 75%+"}
+	for i, l := range legend {
+		legend[i] = heat[i].Sprint(l)
+	}
+	fmt.Fprintln(w, strings.Join(legend, "  "))
+}
+
+// WriteHeatmapCSV writes one row per weekday with the booked share (0–1)
+// of each of the 24 hours.
+func WriteHeatmapCSV(w io.Writer, h *stats.Heatmap, weekStart time.Weekday) {
+	cw := csv.NewWriter(w)
+	header := []string{"weekday"}
+	for hr := range 24 {
+		header = append(header, fmt.Sprintf("%02d:00", hr))
+	}
+	_ = cw.Write(header)
+	for _, d := range heatmapDays(weekStart) {
+		row := []string{d.String()}
+		for _, v := range h.Booked[d] {
+			row = append(row, fmt.Sprintf("%.2f", v))
+		}
+		_ = cw.Write(row)
+	}
+	cw.Flush()
+}
+
+// Sizes of the SVG heatmap, in pixels.
+const (
+	svgCell   = 28
+	svgGap    = 2
+	svgLabelW = 48
+	svgTitleH = 28
+	svgHeadH  = 20
+	svgLegend = 28
+)
+
+// WriteHeatmapSVG writes the heatmap as a standalone SVG image. Each cell
+// has a tooltip with its weekday, hour and booked share.
+func WriteHeatmapSVG(w io.Writer, h *stats.Heatmap, weekStart time.Weekday) {
+	first, last := heatmapHours(h)
+	days := heatmapDays(weekStart)
+	width := svgLabelW + (last-first)*(svgCell+svgGap)
+	height := svgTitleH + svgHeadH + len(days)*(svgCell+svgGap) + svgLegend
+
+	fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="-apple-system, Helvetica, sans-serif" font-size="11">`+"\n",
+		width, height, width, height)
+	fmt.Fprintf(w, `  <text x="0" y="16" font-size="13" font-weight="bold">%s – %s</text>`+"\n",
+		html.EscapeString(locale.Date(h.From, true)), html.EscapeString(locale.Date(h.To.Add(-time.Nanosecond), true)))
+
+	for hr := first; hr < last; hr++ {
+		x := svgLabelW + (hr-first)*(svgCell+svgGap) + svgCell/2
+		fmt.Fprintf(w, `  <text x="%d" y="%d" text-anchor="middle" fill="#666">%s</text>`+"\n",
+			x, svgTitleH+svgHeadH-6, heatmapHourLabel(hr))
+	}
+
+	for i, d := range days {
+		y := svgTitleH + svgHeadH + i*(svgCell+svgGap)
+		fmt.Fprintf(w, `  <text x="0" y="%d" dominant-baseline="middle">%s</text>`+"\n",
+			y+svgCell/2, html.EscapeString(locale.Weekday(d, true)))
+		for hr := first; hr < last; hr++ {
+			v := h.Booked[d][hr]
+			x := svgLabelW + (hr-first)*(svgCell+svgGap)
+			fmt.Fprintf(w, `  <rect x="%d" y="%d" width="%d" height="%d" rx="3" fill="%s"><title>%s %s: %.0f%% booked</title></rect>`+"\n",
+				x, y, svgCell, svgCell, svgFill(v),
+				html.EscapeString(locale.Weekday(d, false)), locale.Clock(time.Date(2000, 1, 1, hr, 0, 0, 0, time.Local)), v*100)
+		}
+	}
+
+	y := height - svgLegend/2
+	fmt.Fprintf(w, `  <text x="0" y="%d" dominant-baseline="middle" fill="#666">Free</text>`+"\n", y)
+	for i, v := range []float64{0, 0.25, 0.5, 0.75, 1} {
+		fmt.Fprintf(w, `  <rect x="%d" y="%d" width="12" height="12" rx="2" fill="%s"/>`+"\n", svgLabelW+i*16, y-6, svgFill(v))
+	}
+	fmt.Fprintf(w, `  <text x="%d" y="%d" dominant-baseline="middle" fill="#666">Booked</text>`+"\n", svgLabelW+5*16+4, y)
+	fmt.Fprintln(w, "</svg>")
+}
+
+// svgFill blends from a light gray for free slots to red for fully
+// booked ones.
+func svgFill(v float64) string {
+	from := [3]float64{0xeb, 0xed, 0xf0}
+	to := [3]float64{0xd7, 0x30, 0x27}
+	var c [3]int
+	for i := range c {
+		c[i] = int(math.Round(from[i] + (to[i]-from[i])*v))
+	}
+	return fmt.Sprintf("#%02x%02x%02x", c[0], c[1], c[2])
+}
@@ -0,0 +1,125 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/ical/internal/stats"
+	"github.com/fatih/color"
+)
+
+func testHeatmap() *stats.Heatmap {
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.Local)
+	h := &stats.Heatmap{From: from, To: from.AddDate(0, 0, 14)}
+	h.Booked[time.Monday][9] = 1
+	h.Booked[time.Monday][10] = 0.5
+	h.Booked[time.Wednesday][7] = 0.1
+	return h
+}
+
+func TestHeatmapLevel(t *testing.T) {
+	tests := []struct {
+		v    float64
+		want int
+	}{
+		{0, 0}, {0.1, 1}, {0.25, 2}, {0.5, 3}, {0.74, 3}, {0.75, 4}, {1, 4},
+	}
+	for _, tt := range tests {
+		if got := heatmapLevel(tt.v); got != tt.want {
+			t.Errorf("heatmapLevel(%v) = %d, want %d", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestHeatmapHourLabel(t *testing.T) {
+	defer SetLocale(DefaultLocale())
+
+	if got := heatmapHourLabel(9); got != "09" {
+		t.Errorf("24h label = %q, want 09", got)
+	}
+	l := DefaultLocale()
+	l.Clock12 = true
+	SetLocale(l)
+	for hr, want := range map[int]string{0: "12a", 9: "9a", 12: "12p", 15: "3p"} {
+		if got := heatmapHourLabel(hr); got != want {
+			t.Errorf("12h label for %d = %q, want %q", hr, got, want)
+		}
+	}
+}
+
+func TestRenderHeatmap(t *testing.T) {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	var buf bytes.Buffer
+	RenderHeatmap(&buf, testHeatmap(), time.Monday)
+	lines := strings.Split(buf.String(), "\n")
+
+	if lines[0] != "Mon 02 Mar 2026 – Sun 15 Mar 2026" {
+		t.Errorf("title = %q", lines[0])
+	}
+	// The window widens from the workday to cover the 07:00 booking.
+	if !strings.HasPrefix(strings.TrimSpace(lines[2]), "07 08 09 10") {
+		t.Errorf("header = %q, want hours from 07", lines[2])
+	}
+	if !strings.HasPrefix(lines[3], "Mon  ·  · ██ ▓▓ ") || !strings.HasSuffix(lines[3], " 14%") {
+		t.Errorf("Monday row = %q", lines[3])
+	}
+	if !strings.HasPrefix(lines[5], "Wed ░░ ") {
+		t.Errorf("Wednesday row = %q", lines[5])
+	}
+	if !strings.HasPrefix(lines[9], "Sun ") {
+		t.Errorf("last row = %q, want Sunday", lines[9])
+	}
+}
+
+func TestWriteHeatmapCSV(t *testing.T) {
+	var buf bytes.Buffer
+	WriteHeatmapCSV(&buf, testHeatmap(), time.Sunday)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+
+	if len(lines) != 8 {
+		t.Fatalf("got %d lines, want header and 7 days", len(lines))
+	}
+	if !strings.HasPrefix(lines[0], "weekday,00:00,01:00,") || !strings.HasSuffix(lines[0], ",23:00") {
+		t.Errorf("header = %q", lines[0])
+	}
+	if !strings.HasPrefix(lines[1], "Sunday,0.00,") {
+		t.Errorf("first row = %q, want Sunday", lines[1])
+	}
+	if !strings.HasPrefix(lines[2], "Monday,0.00,0.00,0.00,0.00,0.00,0.00,0.00,0.00,0.00,1.00,0.50,0.00,") {
+		t.Errorf("Monday row = %q", lines[2])
+	}
+}
+
+func TestWriteHeatmapSVG(t *testing.T) {
+	var buf bytes.Buffer
+	WriteHeatmapSVG(&buf, testHeatmap(), time.Monday)
+	out := buf.String()
+
+	for _, want := range []string{
+		`<svg xmlns="http://www.w3.org/2000/svg"`,
+		`fill="#d73027"><title>Monday 09:00: 100% booked</title></rect>`,
+		`<title>Monday 10:00: 50% booked</title>`,
+		"</svg>",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("SVG missing %q", want)
+		}
+	}
+	// Hours 07 through 17, for 7 days, plus 5 legend swatches.
+	if got := strings.Count(out, "<rect"); got != 11*7+5 {
+		t.Errorf("got %d rects, want %d", got, 11*7+5)
+	}
+}
+
+func TestSVGFill(t *testing.T) {
+	if got := svgFill(0); got != "#ebedf0" {
+		t.Errorf("svgFill(0) = %s", got)
+	}
+	if got := svgFill(1); got != "#d73027" {
+		t.Errorf("svgFill(1) = %s", got)
+	}
+}
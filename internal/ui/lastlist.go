@@ -0,0 +1,153 @@
+package ui
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/charmbracelet/x/term"
+	"golang.org/x/sys/unix"
+)
+
+// lastListStale is how old a listing can be before row lookups warn.
+const lastListStale = time.Hour
+
+// lastListPrune is how long other sessions' listings are kept.
+const lastListPrune = 7 * 24 * time.Hour
+
+// ListedRow is one numbered row of a listing.
+type ListedRow struct {
+	ID    string    `json:"id"`
+	Title string    `json:"title"`
+	Start time.Time `json:"start"`
+}
+
+// LastList is the row-number cache written by every listing, so that
+// "ical show 3" means row 3 of what this terminal last printed.
+type LastList struct {
+	Command string      `json:"command"`
+	SavedAt time.Time   `json:"saved_at"`
+	Rows    []ListedRow `json:"rows"`
+}
+
+// listCommand is recorded with each listing; see SetListCommand.
+var listCommand string
+
+// SetListCommand sets the command line recorded with the next listing.
+func SetListCommand(cmd string) {
+	listCommand = cmd
+}
+
+// CacheDir returns ical's cache directory: $XDG_CACHE_HOME/ical, or
+// ~/.cache/ical.
+func CacheDir() string {
+	dir := os.Getenv("XDG_CACHE_HOME")
+	if dir == "" {
+		home, _ := os.UserHomeDir()
+		dir = filepath.Join(home, ".cache")
+	}
+	return filepath.Join(dir, "ical")
+}
+
+// listSession names the listing cache for this terminal. ICAL_SESSION
+// overrides it; otherwise each terminal session (tab, tmux pane) gets its
+// own, and runs without a terminal, such as scripts and agents, share one.
+func listSession() string {
+	if s := os.Getenv("ICAL_SESSION"); s != "" {
+		return "env-" + filepath.Base(s)
+	}
+	if term.IsTerminal(os.Stdin.Fd()) || term.IsTerminal(os.Stderr.Fd()) {
+		if sid, err := unix.Getsid(0); err == nil {
+			return "tty-" + strconv.Itoa(sid)
+		}
+	}
+	return "default"
+}
+
+func lastListPath() string {
+	return filepath.Join(CacheDir(), "lists", listSession()+".json")
+}
+
+// SaveLastList records the listed events so row numbers can be used later.
+// The file is replaced atomically, and listings from sessions idle for a
+// week are removed.
+func SaveLastList(events []calendar.Event) {
+	list := LastList{Command: listCommand, SavedAt: time.Now(), Rows: make([]ListedRow, len(events))}
+	for i, e := range events {
+		list.Rows[i] = ListedRow{ID: e.ID, Title: e.Title, Start: e.StartDate}
+	}
+	data, err := json.Marshal(list)
+	if err != nil {
+		return
+	}
+
+	path := lastListPath()
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(dir, ".list-*")
+	if err != nil {
+		return
+	}
+	_, werr := tmp.Write(data)
+	cerr := tmp.Close()
+	if werr != nil || cerr != nil || os.Rename(tmp.Name(), path) != nil {
+		_ = os.Remove(tmp.Name())
+		return
+	}
+	pruneLastLists(dir, list.SavedAt)
+}
+
+func pruneLastLists(dir string, now time.Time) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		if info, err := e.Info(); err == nil && now.Sub(info.ModTime()) > lastListPrune {
+			_ = os.Remove(filepath.Join(dir, e.Name()))
+		}
+	}
+}
+
+// LoadLastList returns this session's last listing, or nil if there is
+// none.
+func LoadLastList() *LastList {
+	data, err := os.ReadFile(lastListPath())
+	if err != nil {
+		return nil
+	}
+	var list LastList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil
+	}
+	return &list
+}
+
+// Row returns the 1-based row n.
+func (l *LastList) Row(n int) (ListedRow, bool) {
+	if n < 1 || n > len(l.Rows) {
+		return ListedRow{}, false
+	}
+	return l.Rows[n-1], true
+}
+
+// Stale reports whether the listing is old enough that its row numbers
+// may no longer be what the user has in mind.
+func (l *LastList) Stale(now time.Time) bool {
+	return now.Sub(l.SavedAt) > lastListStale
+}
+
+// Matches reports whether e is still the event that was listed: same
+// title, and the same start unless it repeats (fetching a recurring event
+// by ID returns its first occurrence, not the listed one).
+func (r ListedRow) Matches(e *calendar.Event) bool {
+	if e.Title != r.Title {
+		return false
+	}
+	return e.Recurring || e.StartDate.Equal(r.Start)
+}
@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+func TestLastList(t *testing.T) {
+	cache := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", cache)
+	t.Setenv("ICAL_SESSION", "one")
+
+	if LoadLastList() != nil {
+		t.Fatal("expected no listing before the first save")
+	}
+
+	start := time.Date(2026, 3, 17, 9, 0, 0, 0, time.Local)
+	SetListCommand("ical today")
+	SaveLastList([]calendar.Event{
+		{ID: "a", Title: "Standup", StartDate: start},
+		{ID: "b", Title: "Lunch", StartDate: start.Add(3 * time.Hour)},
+	})
+
+	list := LoadLastList()
+	if list == nil {
+		t.Fatal("listing not saved")
+	}
+	if list.Command != "ical today" {
+		t.Errorf("command = %q", list.Command)
+	}
+	row, ok := list.Row(2)
+	if !ok || row.ID != "b" || row.Title != "Lunch" || !row.Start.Equal(start.Add(3*time.Hour)) {
+		t.Errorf("row 2 = %+v, %v", row, ok)
+	}
+	for _, n := range []int{0, 3} {
+		if _, ok := list.Row(n); ok {
+			t.Errorf("row %d found", n)
+		}
+	}
+
+	// Another session doesn't see, or clobber, this one's rows.
+	t.Setenv("ICAL_SESSION", "two")
+	if LoadLastList() != nil {
+		t.Error("session two sees session one's listing")
+	}
+	SaveLastList(nil)
+	t.Setenv("ICAL_SESSION", "one")
+	if l := LoadLastList(); l == nil || len(l.Rows) != 2 {
+		t.Errorf("session one's listing was replaced: %+v", l)
+	}
+
+	// Listings idle for over a week are pruned on the next save.
+	old := filepath.Join(cache, "ical", "lists", "env-two.json")
+	week := time.Now().Add(-8 * 24 * time.Hour)
+	if err := os.Chtimes(old, week, week); err != nil {
+		t.Fatal(err)
+	}
+	SaveLastList(nil)
+	if _, err := os.Stat(old); !os.IsNotExist(err) {
+		t.Errorf("stale session listing not pruned: %v", err)
+	}
+}
+
+func TestLastListStale(t *testing.T) {
+	saved := time.Date(2026, 3, 17, 9, 0, 0, 0, time.Local)
+	list := &LastList{SavedAt: saved}
+	if list.Stale(saved.Add(30 * time.Minute)) {
+		t.Error("30m old listing is stale")
+	}
+	if !list.Stale(saved.Add(2 * time.Hour)) {
+		t.Error("2h old listing is not stale")
+	}
+}
+
+func TestListedRowMatches(t *testing.T) {
+	start := time.Date(2026, 3, 17, 9, 0, 0, 0, time.Local)
+	row := ListedRow{ID: "a", Title: "Standup", Start: start}
+
+	tests := []struct {
+		name  string
+		event calendar.Event
+		want  bool
+	}{
+		{"unchanged", calendar.Event{Title: "Standup", StartDate: start}, true},
+		{"renamed", calendar.Event{Title: "Retro", StartDate: start}, false},
+		{"moved", calendar.Event{Title: "Standup", StartDate: start.Add(time.Hour)}, false},
+		{"recurring first occurrence", calendar.Event{Title: "Standup", StartDate: start.AddDate(0, 0, -7), Recurring: true}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := row.Matches(&tt.event); got != tt.want {
+				t.Errorf("Matches = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Locale controls how dates and times are displayed. Machine formats
+// (JSON, CSV, ICS) are not affected.
+type Locale struct {
+	// Clock12 shows times as 3:04pm instead of 15:04.
+	Clock12 bool
+	// MonthFirst puts the month before the day: "Tue Mar 17".
+	MonthFirst bool
+	// WeekStart is the default first column of the week and month views.
+	WeekStart time.Weekday
+	// Language selects weekday and month names; see Languages.
+	Language string
+}
+
+// localeNames are the weekday and month names for one language.
+type localeNames struct {
+	days        [7]string // Sunday first, as time.Weekday
+	shortDays   [7]string
+	months      [12]string
+	shortMonths [12]string
+}
+
+var languages = map[string]localeNames{
+	"en": {
+		days:        [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		shortDays:   [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		months:      [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		shortMonths: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	},
+	"de": {
+		days:        [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		shortDays:   [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+		months:      [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		shortMonths: [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+	},
+	"es": {
+		days:        [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		shortDays:   [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		months:      [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		shortMonths: [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sep", "oct", "nov", "dic"},
+	},
+	"fr": {
+		days:        [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		shortDays:   [7]string{"dim", "lun", "mar", "mer", "jeu", "ven", "sam"},
+		months:      [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		shortMonths: [12]string{"janv", "févr", "mars", "avr", "mai", "juin", "juil", "août", "sept", "oct", "nov", "déc"},
+	},
+	"it": {
+		days:        [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		shortDays:   [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+		months:      [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		shortMonths: [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+	},
+	"nl": {
+		days:        [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		shortDays:   [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+		months:      [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		shortMonths: [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+	},
+	"pt": {
+		days:        [7]string{"domingo", "segunda", "terça", "quarta", "quinta", "sexta", "sábado"},
+		shortDays:   [7]string{"dom", "seg", "ter", "qua", "qui", "sex", "sáb"},
+		months:      [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		shortMonths: [12]string{"jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez"},
+	},
+}
+
+// Languages lists the supported Locale.Language codes.
+func Languages() []string {
+	codes := make([]string, 0, len(languages))
+	for code := range languages {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// DefaultLocale is the stock display: 24-hour clock, day before month,
+// weeks starting Monday, English names.
+func DefaultLocale() Locale {
+	return Locale{WeekStart: time.Monday, Language: "en"}
+}
+
+// locale is the display locale for this run.
+var locale = DefaultLocale()
+
+// SetLocale changes the display locale.
+func SetLocale(l Locale) {
+	locale = l
+}
+
+// CurrentLocale returns the display locale.
+func CurrentLocale() Locale {
+	return locale
+}
+
+func (l Locale) names() localeNames {
+	if n, ok := languages[l.Language]; ok {
+		return n
+	}
+	return languages["en"]
+}
+
+// Weekday returns the full or short name of a weekday.
+func (l Locale) Weekday(d time.Weekday, short bool) string {
+	if short {
+		return l.names().shortDays[d]
+	}
+	return l.names().days[d]
+}
+
+// Month returns the full or short name of a month.
+func (l Locale) Month(m time.Month, short bool) string {
+	if short {
+		return l.names().shortMonths[m-1]
+	}
+	return l.names().months[m-1]
+}
+
+// Clock formats a time of day: "15:04" or "3:04pm".
+func (l Locale) Clock(t time.Time) string {
+	if l.Clock12 {
+		return t.Format("3:04pm")
+	}
+	return t.Format("15:04")
+}
+
+// ClockWidth is the widest Clock output, for aligning columns.
+func (l Locale) ClockWidth() int {
+	if l.Clock12 {
+		return 7
+	}
+	return 5
+}
+
+// DayMonth formats a date without weekday or year: "17 Mar" or "Mar 17".
+func (l Locale) DayMonth(t time.Time) string {
+	if l.MonthFirst {
+		return l.Format(t, "Jan 02")
+	}
+	return l.Format(t, "02 Jan")
+}
+
+// Date formats a date with its short weekday: "Tue 17 Mar", adding the
+// year when asked.
+func (l Locale) Date(t time.Time, year bool) string {
+	s := l.Format(t, "Mon ") + l.DayMonth(t)
+	if year {
+		s += t.Format(" 2006")
+	}
+	return s
+}
+
+// ShortDate formats a weekday and day of the month: "Tue 17".
+func (l Locale) ShortDate(t time.Time) string {
+	return l.Format(t, "Mon 02")
+}
+
+// LongDate formats a date with full names: "Tuesday 17 March" or
+// "Tuesday, March 17", adding the year when asked.
+func (l Locale) LongDate(t time.Time, year bool) string {
+	var s string
+	if l.MonthFirst {
+		s = l.Format(t, "Monday, January 02")
+	} else {
+		s = l.Format(t, "Monday 02 January")
+	}
+	if year {
+		s += t.Format(" 2006")
+	}
+	return s
+}
+
+// MonthYear formats a month heading: "March 2026".
+func (l Locale) MonthYear(t time.Time) string {
+	return l.Format(t, "January 2006")
+}
+
+// DateTime formats a date and time: "Tue 17 Mar 15:04".
+func (l Locale) DateTime(t time.Time) string {
+	return l.Date(t, false) + " " + l.Clock(t)
+}
+
+// Timestamp formats a full date, time and zone for detail views:
+// "Tue, 17 Mar 2026 15:04 CET".
+func (l Locale) Timestamp(t time.Time) string {
+	return l.Format(t, "Mon, ") + l.DayMonth(t) + t.Format(" 2006 ") + l.Clock(t) + t.Format(" MST")
+}
+
+// TimeRange formats an event's span for listings: "All Day", "09:00 -
+// 10:00", or dates on both ends when it crosses midnight.
+func (l Locale) TimeRange(start, end time.Time, allDay bool) string {
+	sameDay := start.Year() == end.Year() && start.YearDay() == end.YearDay()
+	if allDay {
+		if sameDay || end.Sub(start) <= 24*time.Hour {
+			return "All Day"
+		}
+		return l.DayMonth(start) + " - " + l.DayMonth(end)
+	}
+	if sameDay {
+		return l.Clock(start) + " - " + l.Clock(end)
+	}
+	return l.DayMonth(start) + " " + l.Clock(start) + " - " + l.DayMonth(end) + " " + l.Clock(end)
+}
+
+// Format is time.Format with weekday and month names ("Monday", "Mon",
+// "January", "Jan") taken from the locale.
+func (l Locale) Format(t time.Time, layout string) string {
+	tokens := []struct {
+		name string
+		text func() string
+	}{
+		{"Monday", func() string { return l.Weekday(t.Weekday(), false) }},
+		{"Mon", func() string { return l.Weekday(t.Weekday(), true) }},
+		{"January", func() string { return l.Month(t.Month(), false) }},
+		{"Jan", func() string { return l.Month(t.Month(), true) }},
+	}
+
+	var b strings.Builder
+	for layout != "" {
+		next := len(layout)
+		matched := false
+		for _, tok := range tokens {
+			if strings.HasPrefix(layout, tok.name) {
+				b.WriteString(tok.text())
+				layout = layout[len(tok.name):]
+				matched = true
+				break
+			}
+			if i := strings.Index(layout, tok.name); i >= 0 && i < next {
+				next = i
+			}
+		}
+		if !matched {
+			b.WriteString(t.Format(layout[:next]))
+			layout = layout[next:]
+		}
+	}
+	return b.String()
+}
+
+// ParseLanguage validates a language code.
+func ParseLanguage(s string) (string, error) {
+	code := strings.ToLower(strings.TrimSpace(s))
+	if _, ok := languages[code]; !ok {
+		return "", fmt.Errorf("unknown language %q (available: %s)", s, strings.Join(Languages(), ", "))
+	}
+	return code, nil
+}
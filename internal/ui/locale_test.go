@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/fatih/color"
+)
+
+func TestLocaleFormats(t *testing.T) {
+	at := time.Date(2026, 3, 17, 14, 5, 0, 0, time.UTC)
+	next := time.Date(2026, 3, 18, 9, 0, 0, 0, time.UTC)
+
+	en := DefaultLocale()
+	us := Locale{Clock12: true, MonthFirst: true, WeekStart: time.Sunday, Language: "en"}
+	de := Locale{WeekStart: time.Monday, Language: "de"}
+	fr := Locale{Language: "fr"}
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"default clock", en.Clock(at), "14:05"},
+		{"default date", en.Date(at, false), "Tue 17 Mar"},
+		{"default date with year", en.Date(at, true), "Tue 17 Mar 2026"},
+		{"default long date", en.LongDate(at, false), "Tuesday 17 March"},
+		{"default timestamp", en.Timestamp(at), "Tue, 17 Mar 2026 14:05 UTC"},
+		{"default same-day range", en.TimeRange(at, at.Add(time.Hour), false), "14:05 - 15:05"},
+		{"default overnight range", en.TimeRange(at, next, false), "17 Mar 14:05 - 18 Mar 09:00"},
+		{"default all day", en.TimeRange(at, at.Add(24*time.Hour), true), "All Day"},
+
+		{"us clock", us.Clock(at), "2:05pm"},
+		{"us date", us.Date(at, false), "Tue Mar 17"},
+		{"us long date", us.LongDate(at, true), "Tuesday, March 17 2026"},
+		{"us range", us.TimeRange(at, at.Add(time.Hour), false), "2:05pm - 3:05pm"},
+		{"us multi-day all day", us.TimeRange(at, at.Add(72*time.Hour), true), "Mar 17 - Mar 20"},
+
+		{"german date", de.Date(at, false), "Di 17 Mär"},
+		{"german long date", de.LongDate(at, false), "Dienstag 17 März"},
+		{"german month", de.MonthYear(at), "März 2026"},
+		{"french long date", fr.LongDate(at, false), "mardi 17 mars"},
+		{"names don't leak into other tokens", de.Format(at, "Monday, Jan 02 15:04"), "Dienstag, Mär 17 14:05"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLanguage(t *testing.T) {
+	if got, err := ParseLanguage(" DE "); err != nil || got != "de" {
+		t.Errorf("ParseLanguage(DE) = %q, %v", got, err)
+	}
+	if _, err := ParseLanguage("xx"); err == nil {
+		t.Error("ParseLanguage(xx) = nil error, want error")
+	}
+}
+
+func TestLocaleInRenderers(t *testing.T) {
+	color.NoColor = true
+	SetLocale(Locale{Clock12: true, WeekStart: time.Sunday, Language: "de"})
+	defer func() {
+		color.NoColor = false
+		SetLocale(DefaultLocale())
+	}()
+
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2026, 3, day, hour, min, 0, 0, time.Local)
+	}
+	events := []calendar.Event{
+		{Title: "Standup", StartDate: at(17, 9, 0), EndDate: at(17, 9, 30), Calendar: "Work"},
+		{Title: "Review", StartDate: at(17, 13, 0), EndDate: at(17, 14, 0), Calendar: "Work"},
+	}
+
+	var buf bytes.Buffer
+	printEventsAgenda(events, &buf, at(16, 12, 0))
+	for _, want := range []string{"Dienstag 17 März", "9:00am–9:30am", "9:30am–1:00pm", "free 3h30m", "1:00pm–2:00pm"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("agenda missing %q:\n%s", want, buf.String())
+		}
+	}
+
+	buf.Reset()
+	RenderMonths(&buf, MonthView{Start: at(1, 0, 0), Months: 1, WeekStart: time.Sunday, Events: events, Now: at(16, 12, 0), Width: 80})
+	lines := strings.Split(buf.String(), "\n")
+	if !strings.Contains(lines[0], "März 2026") {
+		t.Errorf("month title = %q, want März 2026", lines[0])
+	}
+	if got := strings.Fields(lines[1]); strings.Join(got, " ") != "So Mo Di Mi Do Fr Sa" {
+		t.Errorf("weekday headers = %v", got)
+	}
+}
@@ -0,0 +1,264 @@
+package ui
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/BRO3886/go-eventkit/dateparser"
+)
+
+// Markdown and HTML output are for pasting into documents: events are
+// listed under day headings, or as a table with --fields, and a single
+// event becomes a definition list. Both are left uncolored.
+
+// markupItem is an event as one line of a day list: its time range, title,
+// calendar and location.
+func markupItem(it agendaItem) (when, title, rest string) {
+	when = "All day"
+	if !it.event.AllDay {
+		when = agendaTimeRange(it)
+	}
+	rest = "(" + it.event.Calendar + ")"
+	if it.event.Location != "" {
+		rest += " @ " + it.event.Location
+	}
+	return when, it.event.Title, rest
+}
+
+// markupItems lists a day's all-day events, then its timed ones.
+func markupItems(d agendaDay) []agendaItem {
+	return append(d.allDay[:len(d.allDay):len(d.allDay)], d.timed...)
+}
+
+// markupDayLabel names a day by its date, with the year when the events
+// span several years.
+func markupDayLabel(day time.Time, events []calendar.Event) string {
+	return locale.LongDate(day, eventsSpanMultipleYears(events))
+}
+
+// detailField is one entry of the event detail: a label and one or more
+// values.
+type detailField struct {
+	label  string
+	values []string
+}
+
+// eventDetailFields lists the fields of the table detail view, in the
+// same order, as plain text.
+func eventDetailFields(e *calendar.Event) []detailField {
+	start, end := eventTimes(*e)
+	stamp := func(t, orig time.Time) string {
+		if o := localizeTimeInZone(orig, e.TimeZone, DisplayLocation()); o != nil {
+			return fmt.Sprintf("%s (%s)", locale.Timestamp(t), locale.Clock(*o)+o.Format(" MST"))
+		}
+		return locale.Timestamp(t)
+	}
+
+	fields := []detailField{
+		{"Title", []string{e.Title}},
+		{"Calendar", []string{e.Calendar}},
+		{"Status", []string{e.Status.String()}},
+		{"Start", []string{stamp(start, e.StartDate)}},
+		{"End", []string{stamp(end, e.EndDate)}},
+		{"Duration", []string{dateparser.FormatDuration(start, end, e.AllDay)}},
+	}
+	add := func(label string, values ...string) {
+		fields = append(fields, detailField{label, values})
+	}
+	if e.AllDay {
+		add("All Day", "Yes")
+	}
+	if e.Location != "" {
+		add("Location", e.Location)
+	}
+	if e.StructuredLocation != nil {
+		add("Coordinates", fmt.Sprintf("%.4f, %.4f", e.StructuredLocation.Latitude, e.StructuredLocation.Longitude))
+	}
+	if e.URL != "" {
+		add("URL", e.URL)
+	}
+	if e.ConferenceURL != "" {
+		add("Conference", e.ConferenceURL)
+	}
+	if e.TravelTime > 0 {
+		add("Travel Time", formatTravelTime(e.TravelTime))
+	}
+	if e.SelfStatus != calendar.ParticipantStatusUnknown {
+		add("My RSVP", e.SelfStatus.String())
+	}
+	if e.Notes != "" {
+		add("Notes", e.Notes)
+	}
+	if e.Recurring && len(e.RecurrenceRules) > 0 {
+		var rules []string
+		for _, rule := range e.RecurrenceRules {
+			rules = append(rules, FormatRecurrenceRule(rule))
+		}
+		add("Recurrence", rules...)
+	}
+	if len(e.Alerts) > 0 {
+		var alerts []string
+		for _, alert := range e.Alerts {
+			d := alert.RelativeOffset
+			if d < 0 {
+				d = -d
+			}
+			alerts = append(alerts, formatAlertDuration(d)+" before")
+		}
+		add("Alerts", alerts...)
+	}
+	if len(e.Attendees) > 0 {
+		var attendees []string
+		for _, att := range e.Attendees {
+			attendees = append(attendees, fmt.Sprintf("%s <%s> [%s]", att.Name, att.Email, att.Status.String()))
+		}
+		add("Attendees", attendees...)
+	}
+	if e.Organizer != "" {
+		add("Organizer", e.Organizer)
+	}
+	if e.TimeZone != "" {
+		add("Timezone", e.TimeZone)
+	}
+	add("ID", e.ID)
+	add("Created", locale.Timestamp(e.CreatedAt.In(DisplayLocation())))
+	add("Modified", locale.Timestamp(e.ModifiedAt.In(DisplayLocation())))
+	return fields
+}
+
+// Events — Markdown
+
+// markdownEscaper backslash-escapes the characters that would otherwise
+// start emphasis, links, code, HTML or table cells.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`,
+	"<", `\<`, ">", `\>`, "|", `\|`,
+)
+
+// markdownText escapes s for inline Markdown, joining its lines with
+// sep.
+func markdownText(s, sep string) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	for i, l := range lines {
+		lines[i] = markdownEscaper.Replace(strings.TrimSpace(l))
+	}
+	return strings.Join(lines, sep)
+}
+
+func printEventsMarkdown(events []calendar.Event, w io.Writer) {
+	if len(events) == 0 {
+		fmt.Fprintln(w, "No events found.")
+		return
+	}
+	for i, d := range agendaDays(events) {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "### %s\n\n", markdownText(markupDayLabel(d.date, events), " "))
+		for _, it := range markupItems(d) {
+			when, title, rest := markupItem(it)
+			fmt.Fprintf(w, "- %s **%s** %s\n", when, markdownText(title, " "), markdownText(rest, " "))
+		}
+	}
+}
+
+func printEventsFieldsMarkdown(events []calendar.Event, fields []Field, w io.Writer) {
+	if len(events) == 0 {
+		fmt.Fprintln(w, "No events found.")
+		return
+	}
+	header := make([]string, len(fields))
+	rule := make([]string, len(fields))
+	for i, f := range fields {
+		header[i] = markdownText(f.Header, " ")
+		rule[i] = "---"
+	}
+	fmt.Fprintf(w, "| %s |\n", strings.Join(header, " | "))
+	fmt.Fprintf(w, "| %s |\n", strings.Join(rule, " | "))
+	for _, r := range fieldRows(events) {
+		cells := make([]string, len(fields))
+		for i, f := range fields {
+			cells[i] = markdownText(f.text(r), "<br>")
+		}
+		fmt.Fprintf(w, "| %s |\n", strings.Join(cells, " | "))
+	}
+}
+
+// printEventDetailMarkdown writes the event as a definition list, one
+// definition per value.
+func printEventDetailMarkdown(e *calendar.Event, w io.Writer) {
+	for i, f := range eventDetailFields(e) {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintln(w, f.label)
+		for _, v := range f.values {
+			fmt.Fprintf(w, ": %s\n", markdownText(v, "<br>"))
+		}
+	}
+}
+
+// Events — HTML
+
+// htmlText escapes s for HTML, breaking its lines with <br>.
+func htmlText(s string) string {
+	return strings.ReplaceAll(html.EscapeString(strings.TrimRight(s, "\n")), "\n", "<br>")
+}
+
+func printEventsHTML(events []calendar.Event, w io.Writer) {
+	if len(events) == 0 {
+		fmt.Fprintln(w, "<p>No events found.</p>")
+		return
+	}
+	for _, d := range agendaDays(events) {
+		fmt.Fprintf(w, "<h3>%s</h3>\n<ul>\n", htmlText(markupDayLabel(d.date, events)))
+		for _, it := range markupItems(d) {
+			when, title, rest := markupItem(it)
+			datetime := it.start.Format(time.RFC3339)
+			if it.event.AllDay {
+				datetime = it.start.Format(time.DateOnly)
+			}
+			fmt.Fprintf(w, "  <li><time datetime=\"%s\">%s</time> <strong>%s</strong> %s</li>\n",
+				datetime, htmlText(when), htmlText(title), htmlText(rest))
+		}
+		fmt.Fprintln(w, "</ul>")
+	}
+}
+
+func printEventsFieldsHTML(events []calendar.Event, fields []Field, w io.Writer) {
+	if len(events) == 0 {
+		fmt.Fprintln(w, "<p>No events found.</p>")
+		return
+	}
+	fmt.Fprintln(w, "<table>")
+	fmt.Fprint(w, "  <thead><tr>")
+	for _, f := range fields {
+		fmt.Fprintf(w, "<th>%s</th>", htmlText(f.Header))
+	}
+	fmt.Fprintln(w, "</tr></thead>")
+	fmt.Fprintln(w, "  <tbody>")
+	for _, r := range fieldRows(events) {
+		fmt.Fprint(w, "    <tr>")
+		for _, f := range fields {
+			fmt.Fprintf(w, "<td>%s</td>", htmlText(f.text(r)))
+		}
+		fmt.Fprintln(w, "</tr>")
+	}
+	fmt.Fprintln(w, "  </tbody>")
+	fmt.Fprintln(w, "</table>")
+}
+
+func printEventDetailHTML(e *calendar.Event, w io.Writer) {
+	fmt.Fprintln(w, "<dl>")
+	for _, f := range eventDetailFields(e) {
+		fmt.Fprintf(w, "  <dt>%s</dt>\n", htmlText(f.label))
+		for _, v := range f.values {
+			fmt.Fprintf(w, "  <dd>%s</dd>\n", htmlText(v))
+		}
+	}
+	fmt.Fprintln(w, "</dl>")
+}
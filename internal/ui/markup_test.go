@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+func markupEvents() []calendar.Event {
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2026, 3, day, hour, min, 0, 0, time.Local)
+	}
+	return []calendar.Event{
+		{Title: "Standup", StartDate: at(17, 9, 0), EndDate: at(17, 9, 30), Calendar: "Work"},
+		{Title: "Review *v2* | <draft>", StartDate: at(17, 11, 0), EndDate: at(17, 12, 0), Calendar: "Work", Location: "Room 4"},
+		{Title: "Offsite", StartDate: at(17, 0, 0), EndDate: at(18, 0, 0), AllDay: true, Calendar: "Work"},
+		{Title: "Dentist", StartDate: at(18, 8, 0), EndDate: at(18, 9, 0), Calendar: "Home"},
+	}
+}
+
+func TestPrintEventsMarkdown(t *testing.T) {
+	var buf bytes.Buffer
+	printEventsMarkdown(markupEvents(), &buf)
+
+	want := `### Tuesday 17 March
+
+- All day **Offsite** (Work)
+- 09:00–09:30 **Standup** (Work)
+- 11:00–12:00 **Review \*v2\* \| \<draft\>** (Work) @ Room 4
+
+### Wednesday 18 March
+
+- 08:00–09:00 **Dentist** (Home)
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestPrintEventsFieldsMarkdown(t *testing.T) {
+	var buf bytes.Buffer
+	printEventsFieldsMarkdown(markupEvents()[:2], mustFields("index,title,location"), &buf)
+
+	want := `| # | Title | Location |
+| --- | --- | --- |
+| 1 | Standup |  |
+| 2 | Review \*v2\* \| \<draft\> | Room 4 |
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestPrintEventsHTML(t *testing.T) {
+	var buf bytes.Buffer
+	printEventsHTML(markupEvents()[:3], &buf)
+	got := buf.String()
+
+	for _, want := range []string{
+		"<h3>Tuesday 17 March</h3>\n<ul>\n",
+		`<li><time datetime="2026-03-17">All day</time> <strong>Offsite</strong> (Work)</li>`,
+		`<strong>Review *v2* | &lt;draft&gt;</strong> (Work) @ Room 4</li>`,
+		"</ul>\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+
+	buf.Reset()
+	printEventsFieldsHTML(markupEvents()[1:2], mustFields("title"), &buf)
+	if got, want := buf.String(), "<tr><td>Review *v2* | &lt;draft&gt;</td></tr>"; !strings.Contains(got, want) {
+		t.Errorf("missing %q in:\n%s", want, got)
+	}
+}
+
+func TestPrintEventDetailMarkup(t *testing.T) {
+	e := markupEvents()[1]
+	e.ID = "ABC-123"
+	e.Notes = "Agenda:\n- <b>slides</b>"
+	e.Attendees = []calendar.Attendee{
+		{Name: "Ana", Email: "ana@example.com", Status: calendar.ParticipantStatusAccepted},
+		{Name: "Bob", Email: "bob@example.com"},
+	}
+
+	var buf bytes.Buffer
+	printEventDetailMarkdown(&e, &buf)
+	md := buf.String()
+	for _, want := range []string{
+		"Title\n: Review \\*v2\\* \\| \\<draft\\>\n\nCalendar\n: Work\n",
+		"Notes\n: Agenda:<br>- \\<b\\>slides\\</b\\>\n",
+		"Attendees\n: Ana \\<ana@example.com\\> \\[accepted\\]\n: Bob \\<bob@example.com\\> \\[unknown\\]\n",
+		"ID\n: ABC-123\n",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("markdown missing %q in:\n%s", want, md)
+		}
+	}
+
+	buf.Reset()
+	printEventDetailHTML(&e, &buf)
+	h := buf.String()
+	for _, want := range []string{
+		"<dl>\n  <dt>Title</dt>\n  <dd>Review *v2* | &lt;draft&gt;</dd>\n",
+		"<dd>Agenda:<br>- &lt;b&gt;slides&lt;/b&gt;</dd>",
+		"<dt>Attendees</dt>\n  <dd>Ana &lt;ana@example.com&gt; [accepted]</dd>\n  <dd>Bob",
+		"</dl>\n",
+	} {
+		if !strings.Contains(h, want) {
+			t.Errorf("html missing %q in:\n%s", want, h)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/fatih/color"
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+const (
+	// monthCountCell is the cell width in count mode: a right-aligned day
+	// number, a one-digit event count in superscript and the all-day
+	// marker. Cells grow by a column per extra digit of the largest count.
+	monthCountCell = 5
+	// monthTitleCell is the narrowest cell when titles are shown.
+	monthTitleCell = 12
+	// monthGap separates months printed side by side.
+	monthGap = 3
+)
+
+// MonthView is a cal(1)-style grid of one or more months. Each day shows
+// either an event count or up to Titles event titles.
+type MonthView struct {
+	Start     time.Time // first day of the first month
+	Months    int
+	WeekStart time.Weekday
+	Events    []calendar.Event // in row-number order
+	Titles    int
+	Colors    CalendarColors
+	Now       time.Time
+	Width     int
+}
+
+// RenderMonths draws the months, wrapping onto further rows when they
+// don't fit side by side.
+func RenderMonths(w io.Writer, v MonthView) {
+	months := max(v.Months, 1)
+	byDay := eventsByDay(v.Events)
+
+	cell := monthCountCell
+	for _, idx := range byDay {
+		cell = max(cell, monthCountCell-1+len(strconv.Itoa(len(idx))))
+	}
+	sep := 0
+	if v.Titles > 0 {
+		sep = 1
+		perRow := min(months, 3)
+		cell = max(monthTitleCell, (v.Width-(perRow-1)*monthGap)/perRow/7-sep)
+	}
+	blockWidth := 7*cell + 6*sep
+	perRow := max(1, (v.Width+monthGap)/(blockWidth+monthGap))
+
+	blocks := make([][]string, months)
+	for i := range blocks {
+		blocks[i] = monthBlock(v, v.Start.AddDate(0, i, 0), byDay, cell, sep)
+	}
+
+	gap := strings.Repeat(" ", monthGap)
+	for r := 0; r < months; r += perRow {
+		row := blocks[r:min(r+perRow, months)]
+		height := 0
+		for _, b := range row {
+			height = max(height, len(b))
+		}
+		for l := 0; l < height; l++ {
+			parts := make([]string, len(row))
+			for i, b := range row {
+				parts[i] = strings.Repeat(" ", blockWidth)
+				if l < len(b) {
+					parts[i] = b[l]
+				}
+			}
+			fmt.Fprintln(w, strings.TrimRight(strings.Join(parts, gap), " "))
+		}
+		if r+perRow < months {
+			fmt.Fprintln(w)
+		}
+	}
+}
+
+// eventsByDay maps each local date to the row indexes of the events on it.
+// Multi-day events appear on every day they cover.
+func eventsByDay(events []calendar.Event) map[string][]int {
+	byDay := map[string][]int{}
+	for i, e := range events {
+		start, end := eventTimes(e)
+		day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, DisplayLocation())
+		for {
+			next := day.AddDate(0, 0, 1)
+			if !overlapsDay(start, end, day, next) {
+				break
+			}
+			key := day.Format(time.DateOnly)
+			byDay[key] = append(byDay[key], i)
+			day = next
+		}
+	}
+	return byDay
+}
+
+// monthBlock renders one month as fixed-width lines.
+func monthBlock(v MonthView, first time.Time, byDay map[string][]int, cell, sep int) []string {
+	blockWidth := 7*cell + 6*sep
+	bold := color.New(color.Bold)
+	spacer := strings.Repeat(" ", sep)
+
+	title := locale.MonthYear(first)
+	pad := max(0, (blockWidth-runewidth.StringWidth(title))/2)
+	lines := []string{bold.Sprint(fit(strings.Repeat(" ", pad)+title, blockWidth))}
+
+	headers := make([]string, 7)
+	for i := range headers {
+		name := locale.Weekday(time.Weekday((int(v.WeekStart)+i)%7), true)
+		if v.Titles > 0 {
+			headers[i] = fit(name, cell)
+		} else {
+			headers[i] = fit(fmt.Sprintf("%3s", runewidth.Truncate(name, 2, "")), cell)
+		}
+	}
+	lines = append(lines, strings.Join(headers, spacer))
+
+	today := v.Now.Format(time.DateOnly)
+	highlight := color.New(color.ReverseVideo, color.Bold)
+	day := WeekStart(first, v.WeekStart)
+	for day.Before(first.AddDate(0, 1, 0)) {
+		rows := 1 + v.Titles
+		week := make([][]string, rows)
+		for d := 0; d < 7; d++ {
+			date := day.AddDate(0, 0, d)
+			inMonth := date.Month() == first.Month()
+			key := date.Format(time.DateOnly)
+			idx := byDay[key]
+			if !inMonth {
+				idx = nil
+			}
+			cells := monthDayCell(v, date, inMonth, key == today, idx, cell, highlight)
+			for r := range week {
+				week[r] = append(week[r], cells[r])
+			}
+		}
+		for _, r := range week {
+			lines = append(lines, strings.Join(r, spacer))
+		}
+		day = day.AddDate(0, 0, 7)
+	}
+	return lines
+}
+
+// monthDayCell renders the lines of one day: the day number with its
+// markers, then up to v.Titles titles.
+func monthDayCell(v MonthView, date time.Time, inMonth, isToday bool, idx []int, cell int, highlight *color.Color) []string {
+	lines := make([]string, 1+v.Titles)
+	blank := strings.Repeat(" ", cell)
+	for i := range lines {
+		lines[i] = blank
+	}
+	if !inMonth {
+		return lines
+	}
+
+	allDay := false
+	for _, i := range idx {
+		if v.Events[i].AllDay {
+			allDay = true
+		}
+	}
+	marker := " "
+	if allDay {
+		marker = "*"
+	}
+
+	var num string
+	if v.Titles > 0 {
+		num = fmt.Sprintf("%2d", date.Day())
+	} else {
+		num = fmt.Sprintf("%3d", date.Day())
+	}
+	if isToday {
+		num = highlight.Sprint(num)
+	}
+
+	if v.Titles == 0 {
+		lines[0] = num + superscriptCount(len(idx), cell-4) + marker
+		return lines
+	}
+
+	lines[0] = num + fit(marker, cell-2)
+	for r := 0; r < v.Titles && r < len(idx); r++ {
+		if r == v.Titles-1 && len(idx) > v.Titles {
+			lines[r+1] = fit(fmt.Sprintf("+%d more", len(idx)-r), cell)
+			break
+		}
+		e := v.Events[idx[r]]
+		label := fmt.Sprintf("%d %s", idx[r]+1, e.Title)
+		if e.AllDay {
+			label = fmt.Sprintf("%d ■%s", idx[r]+1, e.Title)
+		}
+		lines[r+1] = v.Colors.For(e.Calendar).Sprint(fit(label, cell))
+	}
+	return lines
+}
+
+// superscriptCount renders an event count in superscript digits so it
+// reads apart from the day number, padded to width: blank for none.
+func superscriptCount(n, width int) string {
+	var b strings.Builder
+	if n > 0 {
+		for _, d := range strconv.Itoa(n) {
+			b.WriteRune([]rune("⁰¹²³⁴⁵⁶⁷⁸⁹")[d-'0'])
+		}
+	}
+	return b.String() + strings.Repeat(" ", max(0, width-runewidth.StringWidth(b.String())))
+}
@@ -0,0 +1,167 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/fatih/color"
+)
+
+func monthTestEvents() []calendar.Event {
+	at := func(day, hour int) time.Time {
+		return time.Date(2026, 3, day, hour, 0, 0, 0, time.Local)
+	}
+	return []calendar.Event{
+		{Title: "Standup", StartDate: at(2, 9), EndDate: at(2, 10), Calendar: "Work"},
+		{Title: "Lunch", StartDate: at(2, 12), EndDate: at(2, 13), Calendar: "Home"},
+		{Title: "Review", StartDate: at(2, 15), EndDate: at(2, 16), Calendar: "Work"},
+		{Title: "Offsite", StartDate: at(4, 0), EndDate: at(6, 0), AllDay: true, Calendar: "Work"},
+		{Title: "Dinner", StartDate: at(31, 19), EndDate: at(31, 21), Calendar: "Home"},
+	}
+}
+
+func TestEventsByDay(t *testing.T) {
+	byDay := eventsByDay(monthTestEvents())
+
+	tests := []struct {
+		day  string
+		want []int
+	}{
+		{"2026-03-02", []int{0, 1, 2}},
+		{"2026-03-04", []int{3}},
+		{"2026-03-05", []int{3}},
+		{"2026-03-06", nil},
+		{"2026-03-31", []int{4}},
+	}
+	for _, tt := range tests {
+		got := byDay[tt.day]
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.day, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: got %v, want %v", tt.day, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestRenderMonthsCounts(t *testing.T) {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	var buf bytes.Buffer
+	RenderMonths(&buf, MonthView{
+		Start:     time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local),
+		Months:    3,
+		WeekStart: time.Monday,
+		Events:    monthTestEvents(),
+		Colors:    CalendarColors{},
+		Now:       time.Date(2026, 3, 2, 10, 0, 0, 0, time.Local),
+		Width:     120,
+	})
+	out := buf.String()
+	lines := strings.Split(out, "\n")
+
+	// Three months side by side on the first line.
+	for _, want := range []string{"March 2026", "April 2026", "May 2026"} {
+		if !strings.Contains(lines[0], want) {
+			t.Errorf("header line missing %q: %q", want, lines[0])
+		}
+	}
+	if !strings.HasPrefix(lines[1], " Mo   Tu") {
+		t.Errorf("weekday header = %q", lines[1])
+	}
+	// 1 March 2026 is a Sunday, so the first week has only its last cell.
+	if !strings.HasPrefix(lines[2], strings.Repeat(" ", 30)+"  1") {
+		t.Errorf("first week = %q", lines[2])
+	}
+	// Monday 2nd has three events; Wednesday 4th is all-day.
+	if !strings.Contains(lines[3], "  2³   3    4¹*  5¹*  6") {
+		t.Errorf("second week = %q", lines[3])
+	}
+}
+
+func TestRenderMonthsTwoDigitCounts(t *testing.T) {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	day := time.Date(2026, 3, 2, 8, 0, 0, 0, time.Local)
+	var events []calendar.Event
+	for i := 0; i < 12; i++ {
+		start := day.Add(time.Duration(i) * 30 * time.Minute)
+		events = append(events, calendar.Event{Title: "Slot", StartDate: start, EndDate: start.Add(30 * time.Minute)})
+	}
+	events = append(events, calendar.Event{Title: "Late", StartDate: day.AddDate(0, 0, 1), EndDate: day.AddDate(0, 0, 1).Add(time.Hour)})
+
+	var buf bytes.Buffer
+	RenderMonths(&buf, MonthView{
+		Start:     time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local),
+		Months:    1,
+		WeekStart: time.Monday,
+		Events:    events,
+		Colors:    CalendarColors{},
+		Now:       time.Date(2026, 3, 20, 10, 0, 0, 0, time.Local),
+		Width:     120,
+	})
+	lines := strings.Split(buf.String(), "\n")
+	// Cells widen by one column so 12 is not confused with other counts.
+	if !strings.HasPrefix(lines[3], "  2¹²   3¹    4     5") {
+		t.Errorf("second week = %q", lines[3])
+	}
+	if got := superscriptCount(40, 2); got != "⁴⁰" {
+		t.Errorf("superscriptCount(40) = %q", got)
+	}
+}
+
+func TestRenderMonthsTitles(t *testing.T) {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	var buf bytes.Buffer
+	RenderMonths(&buf, MonthView{
+		Start:     time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local),
+		Months:    1,
+		WeekStart: time.Sunday,
+		Events:    monthTestEvents(),
+		Titles:    2,
+		Colors:    CalendarColors{},
+		Now:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.Local),
+		Width:     120,
+	})
+	out := buf.String()
+
+	for _, want := range []string{"Sun", "1 Standup", "+2 more", "4 ■Offsite", "5 Dinner"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "2 Lunch") {
+		t.Errorf("day with more events than --titles should collapse the rest:\n%s", out)
+	}
+}
+
+func TestRenderMonthsWraps(t *testing.T) {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	var buf bytes.Buffer
+	RenderMonths(&buf, MonthView{
+		Start:     time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local),
+		Months:    2,
+		WeekStart: time.Monday,
+		Now:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.Local),
+		Width:     40,
+	})
+	lines := strings.Split(buf.String(), "\n")
+	if strings.Contains(lines[0], "April") {
+		t.Errorf("months should wrap at width 40:\n%s", buf.String())
+	}
+	if !strings.Contains(buf.String(), "April 2026") {
+		t.Errorf("second month missing:\n%s", buf.String())
+	}
+}
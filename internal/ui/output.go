@@ -1,11 +1,12 @@
 package ui
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
-	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,72 +19,102 @@ import (
 	"github.com/olekukonko/tablewriter/tw"
 )
 
-// lastListPath returns the path to the cached event ID list.
-func lastListPath() string {
-	home, _ := os.UserHomeDir()
-	return filepath.Join(home, ".ical-last-list")
-}
-
-// SaveLastList writes event IDs to cache so row numbers can be used later.
-func SaveLastList(events []calendar.Event) {
-	ids := make([]string, len(events))
-	for i, e := range events {
-		ids[i] = e.ID
-	}
-	_ = os.WriteFile(lastListPath(), []byte(strings.Join(ids, "\n")+"\n"), 0644)
-}
-
-// LookupRowNumber returns the full event ID for a 1-based row number
-// from the last listing cache. Returns "" if not found.
-func LookupRowNumber(n int) string {
-	data, err := os.ReadFile(lastListPath())
-	if err != nil {
-		return ""
-	}
-	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
-	if n < 1 || n > len(lines) {
-		return ""
-	}
-	return lines[n-1]
-}
-
 // PrintEvents prints events in the specified format and caches event IDs
-// for row-number-based lookup by show/update/delete.
-func PrintEvents(events []calendar.Event, format string) {
+// for row-number-based lookup by show/update/delete. Only a template
+// format can fail.
+func PrintEvents(events []calendar.Event, format string) error {
 	SaveLastList(events)
+	if IsTemplateFormat(format) {
+		return printEventsTemplate(events, format, os.Stdout)
+	}
+	fields := selectedFields
 	switch format {
 	case "json":
+		if fields != nil {
+			printEventsFieldsJSON(events, fields, os.Stdout)
+			return nil
+		}
 		printEventsJSON(events, os.Stdout)
 	case "plain":
+		if fields != nil {
+			printEventsFieldsPlain(events, fields, os.Stdout)
+			return nil
+		}
 		printEventsPlain(events, os.Stdout)
+	case "agenda":
+		printEventsAgenda(events, os.Stdout, time.Now())
+	case "markdown":
+		if fields != nil {
+			printEventsFieldsMarkdown(events, fields, os.Stdout)
+			return nil
+		}
+		printEventsMarkdown(events, os.Stdout)
+	case "html":
+		if fields != nil {
+			printEventsFieldsHTML(events, fields, os.Stdout)
+			return nil
+		}
+		printEventsHTML(events, os.Stdout)
+	case "csv":
+		if fields == nil {
+			fields = defaultFields
+		}
+		printEventsCSV(events, fields, os.Stdout)
 	default:
+		if fields != nil {
+			printEventsFieldsTable(events, fields, os.Stdout)
+			return nil
+		}
 		printEventsTable(events, os.Stdout)
 	}
+	return nil
 }
 
 // PrintCalendars prints calendars in the specified format.
-func PrintCalendars(calendars []calendar.Calendar, format string) {
+func PrintCalendars(calendars []calendar.Calendar, format string) error {
+	if IsTemplateFormat(format) {
+		return executeTemplate(format, calendars, os.Stdout)
+	}
 	switch format {
 	case "json":
 		printCalendarsJSON(calendars, os.Stdout)
 	case "plain":
 		printCalendarsPlain(calendars, os.Stdout)
+	case "csv":
+		printCalendarsCSV(calendars, os.Stdout)
 	default:
 		printCalendarsTable(calendars, os.Stdout)
 	}
+	return nil
 }
 
 // PrintEventDetail prints a single event with full details.
-func PrintEventDetail(event *calendar.Event, format string) {
+func PrintEventDetail(event *calendar.Event, format string) error {
+	if IsTemplateFormat(format) {
+		return printEventsTemplate([]calendar.Event{*event}, format, os.Stdout)
+	}
 	switch format {
 	case "json":
 		data, _ := json.MarshalIndent(toEventJSON(*event), "", "  ")
 		fmt.Println(string(data))
 	case "plain":
 		printEventDetailPlain(event, os.Stdout)
+	case "markdown":
+		printEventDetailMarkdown(event, os.Stdout)
+	case "html":
+		printEventDetailHTML(event, os.Stdout)
 	default:
 		printEventDetailTable(event, os.Stdout)
 	}
+	return nil
+}
+
+// EventDetail returns the detail view PrintEventDetail shows for table
+// output, for embedding in other views.
+func EventDetail(event *calendar.Event) string {
+	var b strings.Builder
+	printEventDetailTable(event, &b)
+	return b.String()
 }
 
 // Events — Table
@@ -95,6 +126,7 @@ func printEventsTable(events []calendar.Event, w io.Writer) {
 	}
 
 	showYear := eventsSpanMultipleYears(events)
+	conflicts := findConflicts(events)
 
 	t := tablewriter.NewTable(w,
 		tablewriter.WithConfig(tablewriter.Config{
@@ -108,26 +140,84 @@ func printEventsTable(events []calendar.Event, w io.Writer) {
 			},
 		}),
 	)
-	t.Header("#", "Date", "Time", "Title", "Calendar", "Location", "Duration")
 
+	// Build plain cells first so the layout can measure them.
+	rows := make([][numTableCols]string, len(events))
+	markers := make([]string, len(events))
+	markerWidths := make([]int, len(events))
+	var natural [numTableCols]int
+	for c, h := range tableHeaders {
+		natural[c] = runewidth.StringWidth(h)
+	}
 	for i, e := range events {
-		start := localizeTime(e.StartDate, e.TimeZone)
-		end := localizeTime(e.EndDate, e.TimeZone)
-		dateStr := eventDateLabel(start, showYear)
-		timeStr := dateparser.FormatTimeRange(start, end, e.AllDay)
-		title := truncate(e.Title, 40)
-		loc := truncate(e.Location, 25)
-		dur := dateparser.FormatDuration(start, end, e.AllDay)
-		calName := e.Calendar
-
-		if e.AllDay {
-			title = color.HiYellowString(title)
+		start, end := eventTimes(e)
+		rows[i] = [numTableCols]string{
+			colNum:      fmt.Sprintf("%d", i+1),
+			colDate:     eventDateLabel(start, showYear),
+			colTime:     locale.TimeRange(start, end, e.AllDay),
+			colTitle:    e.Title,
+			colCalendar: e.Calendar,
+			colLocation: e.Location,
+			colDuration: dateparser.FormatDuration(start, end, e.AllDay),
 		}
 		if e.Recurring {
-			title = title + " " + color.HiCyanString("↻")
+			markers[i] += " " + color.HiCyanString("↻")
+			markerWidths[i] += 2
+		}
+		if len(conflicts[i]) > 0 {
+			markers[i] += " " + conflictMarker()
+			markerWidths[i] += 2
+		}
+		for c, cell := range rows[i] {
+			w := runewidth.StringWidth(cell)
+			switch c {
+			case colTitle:
+				w += markerWidths[i]
+			case colCalendar:
+				if calendarBullet(cell) != "" {
+					w += 2
+				}
+			}
+			natural[c] = max(natural[c], w)
+		}
+	}
+	width := detectedWidth()
+	layout := planTable(natural, width)
+
+	var header []any
+	for c, h := range tableHeaders {
+		if layout.show[c] {
+			header = append(header, h)
+		}
+	}
+	t.Header(header...)
+
+	for i, e := range events {
+		row := rows[i]
+		if layout.title > 0 {
+			limit := layout.title
+			if width > 0 {
+				// The markers were counted in the title's width.
+				limit = max(limit-markerWidths[i], 1)
+			}
+			row[colTitle] = truncate(row[colTitle], limit)
+		}
+		if layout.location > 0 {
+			row[colLocation] = truncate(row[colLocation], layout.location)
+		}
+		if e.AllDay {
+			row[colTitle] = color.HiYellowString(row[colTitle])
 		}
+		row[colTitle] += markers[i]
+		row[colCalendar] = calendarBullet(e.Calendar) + row[colCalendar]
 
-		t.Append(fmt.Sprintf("%d", i+1), dateStr, timeStr, title, calName, loc, dur)
+		var cells []any
+		for c, cell := range row {
+			if layout.show[c] {
+				cells = append(cells, cell)
+			}
+		}
+		t.Append(cells...)
 	}
 
 	t.Render()
@@ -162,6 +252,7 @@ type eventJSON struct {
 	TimeZone           string                       `json:"timezone,omitempty"`
 	CreatedAt          time.Time                    `json:"created_at"`
 	ModifiedAt         time.Time                    `json:"modified_at"`
+	Conflicts          []string                     `json:"conflicts,omitempty"`
 }
 
 func toEventJSON(e calendar.Event) eventJSON {
@@ -196,9 +287,11 @@ func toEventJSON(e calendar.Event) eventJSON {
 }
 
 func printEventsJSON(events []calendar.Event, w io.Writer) {
+	conflicts := findConflicts(events)
 	out := make([]eventJSON, len(events))
 	for i, e := range events {
 		out[i] = toEventJSON(e)
+		out[i].Conflicts = conflictIDs(events, conflicts[i])
 	}
 	data, _ := json.Marshal(out)
 	fmt.Fprintln(w, string(data))
@@ -208,8 +301,7 @@ func printEventsJSON(events []calendar.Event, w io.Writer) {
 
 func printEventsPlain(events []calendar.Event, w io.Writer) {
 	for i, e := range events {
-		start := localizeTime(e.StartDate, e.TimeZone)
-		end := localizeTime(e.EndDate, e.TimeZone)
+		start, end := eventTimes(e)
 		if e.AllDay {
 			loc := ""
 			if e.Location != "" {
@@ -223,8 +315,8 @@ func printEventsPlain(events []calendar.Event, w io.Writer) {
 			}
 			fmt.Fprintf(w, "#%d [%s-%s] %s (%s)%s\n",
 				i+1,
-				start.Format("15:04"),
-				end.Format("15:04"),
+				locale.Clock(start),
+				locale.Clock(end),
 				e.Title,
 				e.Calendar,
 				loc,
@@ -254,7 +346,11 @@ func printCalendarsTable(calendars []calendar.Calendar, w io.Writer) {
 		if c.ReadOnly {
 			readOnly = "yes"
 		}
-		t.Append(c.Title, c.Source, c.Type.String(), c.Color, readOnly)
+		swatch := c.Color
+		if col := hexColor(c.Color); col != nil && !color.NoColor {
+			swatch = col.Sprint("●") + " " + c.Color
+		}
+		t.Append(c.Title, c.Source, c.Type.String(), swatch, readOnly)
 	}
 
 	t.Render()
@@ -279,35 +375,45 @@ func printCalendarsPlain(calendars []calendar.Calendar, w io.Writer) {
 	}
 }
 
+// Calendars — CSV
+
+func printCalendarsCSV(calendars []calendar.Calendar, w io.Writer) {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"name", "source", "type", "color", "read_only", "id"})
+	for _, c := range calendars {
+		_ = cw.Write([]string{c.Title, c.Source, c.Type.String(), c.Color, strconv.FormatBool(c.ReadOnly), c.ID})
+	}
+	cw.Flush()
+}
+
 // Event Detail
 
 func printEventDetailTable(e *calendar.Event, w io.Writer) {
 	bold := color.New(color.Bold)
 
-	start := localizeTime(e.StartDate, e.TimeZone)
-	end := localizeTime(e.EndDate, e.TimeZone)
+	start, end := eventTimes(*e)
 
 	bold.Fprintf(w, "Title:        ")
 	fmt.Fprintln(w, e.Title)
 
 	bold.Fprintf(w, "Calendar:     ")
-	fmt.Fprintln(w, e.Calendar)
+	fmt.Fprintln(w, calendarBullet(e.Calendar)+e.Calendar)
 
 	bold.Fprintf(w, "Status:       ")
 	fmt.Fprintln(w, e.Status.String())
 
 	bold.Fprintf(w, "Start:        ")
-	if origStart := localizeTimeInZone(e.StartDate, e.TimeZone, time.Local); origStart != nil {
-		fmt.Fprintf(w, "%s  (%s)\n", start.Format("Mon, 02 Jan 2006 15:04 MST"), origStart.Format("15:04 MST"))
+	if origStart := localizeTimeInZone(e.StartDate, e.TimeZone, DisplayLocation()); origStart != nil {
+		fmt.Fprintf(w, "%s  (%s)\n", locale.Timestamp(start), locale.Clock(*origStart)+origStart.Format(" MST"))
 	} else {
-		fmt.Fprintln(w, start.Format("Mon, 02 Jan 2006 15:04 MST"))
+		fmt.Fprintln(w, locale.Timestamp(start))
 	}
 
 	bold.Fprintf(w, "End:          ")
-	if origEnd := localizeTimeInZone(e.EndDate, e.TimeZone, time.Local); origEnd != nil {
-		fmt.Fprintf(w, "%s  (%s)\n", end.Format("Mon, 02 Jan 2006 15:04 MST"), origEnd.Format("15:04 MST"))
+	if origEnd := localizeTimeInZone(e.EndDate, e.TimeZone, DisplayLocation()); origEnd != nil {
+		fmt.Fprintf(w, "%s  (%s)\n", locale.Timestamp(end), locale.Clock(*origEnd)+origEnd.Format(" MST"))
 	} else {
-		fmt.Fprintln(w, end.Format("Mon, 02 Jan 2006 15:04 MST"))
+		fmt.Fprintln(w, locale.Timestamp(end))
 	}
 
 	bold.Fprintf(w, "Duration:     ")
@@ -401,23 +507,22 @@ func printEventDetailTable(e *calendar.Event, w io.Writer) {
 	fmt.Fprintln(w, e.ID)
 
 	bold.Fprintf(w, "Created:      ")
-	fmt.Fprintln(w, e.CreatedAt.In(time.Local).Format("Mon, 02 Jan 2006 15:04 MST"))
+	fmt.Fprintln(w, locale.Timestamp(e.CreatedAt.In(DisplayLocation())))
 
 	bold.Fprintf(w, "Modified:     ")
-	fmt.Fprintln(w, e.ModifiedAt.In(time.Local).Format("Mon, 02 Jan 2006 15:04 MST"))
+	fmt.Fprintln(w, locale.Timestamp(e.ModifiedAt.In(DisplayLocation())))
 }
 
 func printEventDetailPlain(e *calendar.Event, w io.Writer) {
-	start := localizeTime(e.StartDate, e.TimeZone)
-	end := localizeTime(e.EndDate, e.TimeZone)
+	start, end := eventTimes(*e)
 	fmt.Fprintf(w, "Title: %s\n", e.Title)
 	fmt.Fprintf(w, "Calendar: %s\n", e.Calendar)
-	if origStart := localizeTimeInZone(e.StartDate, e.TimeZone, time.Local); origStart != nil {
+	if origStart := localizeTimeInZone(e.StartDate, e.TimeZone, DisplayLocation()); origStart != nil {
 		fmt.Fprintf(w, "Start: %s  (%s)\n", start.Format(time.RFC3339), origStart.Format(time.RFC3339))
 	} else {
 		fmt.Fprintf(w, "Start: %s\n", start.Format(time.RFC3339))
 	}
-	if origEnd := localizeTimeInZone(e.EndDate, e.TimeZone, time.Local); origEnd != nil {
+	if origEnd := localizeTimeInZone(e.EndDate, e.TimeZone, DisplayLocation()); origEnd != nil {
 		fmt.Fprintf(w, "End: %s  (%s)\n", end.Format(time.RFC3339), origEnd.Format(time.RFC3339))
 	} else {
 		fmt.Fprintf(w, "End: %s\n", end.Format(time.RFC3339))
@@ -532,7 +637,7 @@ func FormatRecurrenceRule(rule eventkit.RecurrenceRule) string {
 
 	if rule.End != nil {
 		if rule.End.EndDate != nil {
-			fmt.Fprintf(&b, " until %s", rule.End.EndDate.Format("Jan 2, 2006"))
+			fmt.Fprintf(&b, " until %s", locale.Date(*rule.End.EndDate, true))
 		}
 		if rule.End.OccurrenceCount > 0 {
 			fmt.Fprintf(&b, " for %d occurrences", rule.End.OccurrenceCount)
@@ -590,16 +695,47 @@ func formatAlertDuration(d time.Duration) string {
 	return fmt.Sprintf("%d minutes", mins)
 }
 
-// localizeTime converts a time to the system's local timezone.
+// displayLoc is the zone set with SetDisplayLocation; nil means the
+// system's local zone.
+var displayLoc *time.Location
+
+// SetDisplayLocation shows times in loc (--tz) instead of the system's
+// local zone. nil restores the local zone.
+func SetDisplayLocation(loc *time.Location) {
+	displayLoc = loc
+}
+
+// DisplayLocation returns the zone times are shown in.
+func DisplayLocation() *time.Location {
+	if displayLoc != nil {
+		return displayLoc
+	}
+	return time.Local
+}
+
+// localizeTime converts a time to the display zone.
 func localizeTime(t time.Time, _ string) time.Time {
-	return t.In(time.Local)
+	return t.In(DisplayLocation())
 }
 
-func eventDateLabel(t time.Time, showYear bool) string {
-	if showYear {
-		return t.Format("Mon 02 Jan 2006")
+// DisplayTime converts an event time to the display zone. All-day events
+// are floating dates, so they keep their wall-clock date and time rather
+// than shifting to a neighbouring day.
+func DisplayTime(t time.Time, allDay bool) time.Time {
+	if !allDay {
+		return localizeTime(t, "")
 	}
-	return t.Format("Mon 02 Jan")
+	t = t.In(time.Local)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), DisplayLocation())
+}
+
+// eventTimes returns an event's start and end in the display zone.
+func eventTimes(e calendar.Event) (start, end time.Time) {
+	return DisplayTime(e.StartDate, e.AllDay), DisplayTime(e.EndDate, e.AllDay)
+}
+
+func eventDateLabel(t time.Time, showYear bool) string {
+	return locale.Date(t, showYear)
 }
 
 func eventsSpanMultipleYears(events []calendar.Event) bool {
@@ -652,13 +788,12 @@ func ShortID(id string) string {
 
 // PrintCreatedEvent prints summary info for a newly created event.
 func PrintCreatedEvent(e *calendar.Event) {
-	start := localizeTime(e.StartDate, e.TimeZone)
-	end := localizeTime(e.EndDate, e.TimeZone)
+	start, end := eventTimes(*e)
 	green := color.New(color.FgGreen, color.Bold)
 	green.Print("Created: ")
 	fmt.Printf("%s\n", e.Title)
 	fmt.Printf("  Calendar: %s\n", e.Calendar)
-	fmt.Printf("  When:     %s\n", dateparser.FormatTimeRange(start, end, e.AllDay))
+	fmt.Printf("  When:     %s\n", locale.TimeRange(start, end, e.AllDay))
 	fmt.Printf("  ID:       %s\n", ShortID(e.ID))
 }
 
@@ -688,12 +823,11 @@ func PrintUpdatedCalendar(c *calendar.Calendar) {
 
 // PrintUpdatedEvent prints summary info for an updated event.
 func PrintUpdatedEvent(e *calendar.Event) {
-	start := localizeTime(e.StartDate, e.TimeZone)
-	end := localizeTime(e.EndDate, e.TimeZone)
+	start, end := eventTimes(*e)
 	green := color.New(color.FgGreen, color.Bold)
 	green.Print("Updated: ")
 	fmt.Printf("%s\n", e.Title)
 	fmt.Printf("  Calendar: %s\n", e.Calendar)
-	fmt.Printf("  When:     %s\n", dateparser.FormatTimeRange(start, end, e.AllDay))
+	fmt.Printf("  When:     %s\n", locale.TimeRange(start, end, e.AllDay))
 	fmt.Printf("  ID:       %s\n", ShortID(e.ID))
 }
@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 	"time"
 
@@ -88,7 +90,7 @@ func TestFormatRecurrenceRule(t *testing.T) {
 		{
 			"daily until date",
 			eventkit.Daily(1).Until(until),
-			"Every day until Mar 15, 2027",
+			"Every day until Mon 15 Mar 2027",
 		},
 		{
 			"weekly for 10 occurrences",
@@ -345,3 +347,53 @@ func TestTruncate(t *testing.T) {
 		})
 	}
 }
+
+func TestDisplayLocation(t *testing.T) {
+	london, err1 := time.LoadLocation("Europe/London")
+	tokyo, err2 := time.LoadLocation("Asia/Tokyo")
+	la, err3 := time.LoadLocation("America/Los_Angeles")
+	if err1 != nil || err2 != nil || err3 != nil {
+		t.Skip("no tzdata")
+	}
+	defer func(l *time.Location) { time.Local = l }(time.Local)
+	time.Local = london
+	defer SetDisplayLocation(nil)
+
+	events := []calendar.Event{
+		// All-day events are floating midnights in the system zone.
+		{Title: "Holiday", Calendar: "Home", AllDay: true,
+			StartDate: time.Date(2026, 3, 17, 0, 0, 0, 0, london), EndDate: time.Date(2026, 3, 18, 0, 0, 0, 0, london)},
+		{Title: "Standup", Calendar: "Work",
+			StartDate: time.Date(2026, 3, 17, 9, 0, 0, 0, time.UTC), EndDate: time.Date(2026, 3, 17, 9, 30, 0, 0, time.UTC)},
+	}
+
+	tests := []struct {
+		name string
+		loc  *time.Location
+		want string
+	}{
+		{"system zone", nil, "### Tuesday 17 March\n\n- All day **Holiday** (Home)\n- 09:00–09:30 **Standup** (Work)\n"},
+		{"east", tokyo, "### Tuesday 17 March\n\n- All day **Holiday** (Home)\n- 18:00–18:30 **Standup** (Work)\n"},
+		{"west keeps all-day date", la, "### Tuesday 17 March\n\n- All day **Holiday** (Home)\n- 02:00–02:30 **Standup** (Work)\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetDisplayLocation(tt.loc)
+			var buf bytes.Buffer
+			printEventsMarkdown(events, &buf)
+			if got := buf.String(); got != tt.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+
+	SetDisplayLocation(la)
+	var buf bytes.Buffer
+	printEventsPlain(events, &buf)
+	if got, want := buf.String(), "#2 [02:00-02:30] Standup (Work)"; !strings.Contains(got, want) {
+		t.Errorf("plain = %q, want %q", got, want)
+	}
+	if got := DisplayTime(events[0].StartDate, true); got.Day() != 17 || got.Hour() != 0 || got.Location() != la {
+		t.Errorf("all-day start = %v, want 17 March 00:00 in LA", got)
+	}
+}
@@ -0,0 +1,427 @@
+package ui
+
+import (
+	"fmt"
+	"hash/fnv"
+	"html"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+// PlannerView is a printable week or month page.
+type PlannerView struct {
+	Month     bool         // a month grid rather than a week
+	Start     time.Time    // first day of the week, or the 1st of the month
+	WeekStart time.Weekday // first column of the month grid
+	Events    []calendar.Event
+	Calendars []calendar.Calendar
+}
+
+// plannerPalette colors calendars that have no color of their own.
+var plannerPalette = []string{"#1badf8", "#63da38", "#cc73e1", "#ff9500", "#ff2968", "#a2845e"}
+
+// plannerDayLines is how many events an HTML month cell lists before
+// "+N more".
+const plannerDayLines = 6
+
+// Page geometry of the SVG planner, in pixels: A4 landscape at 96 dpi.
+const (
+	plannerWidth   = 1123
+	plannerHeight  = 794
+	plannerMargin  = 24
+	plannerGutter  = 44
+	plannerHeadH   = 20
+	plannerLegendH = 28
+	plannerCharW   = 6 // rough width of an 11px glyph, for truncating titles
+)
+
+func (v PlannerView) title() string {
+	if v.Month {
+		return locale.MonthYear(v.Start)
+	}
+	return locale.Date(v.Start, true) + " – " + locale.Date(v.Start.AddDate(0, 0, 6), true)
+}
+
+// colors maps each calendar in the view to a "#rrggbb" color.
+func (v PlannerView) colors() map[string]string {
+	colors := map[string]string{}
+	for _, c := range v.Calendars {
+		if r, g, b, ok := parseHex(c.Color); ok {
+			colors[c.Title] = fmt.Sprintf("#%02x%02x%02x", r, g, b)
+		}
+	}
+	for _, e := range v.Events {
+		if _, ok := colors[e.Calendar]; !ok {
+			h := fnv.New32a()
+			h.Write([]byte(e.Calendar))
+			colors[e.Calendar] = plannerPalette[h.Sum32()%uint32(len(plannerPalette))]
+		}
+	}
+	return colors
+}
+
+// legend lists the calendars that have events in the view, in the order
+// of the calendar list.
+func (v PlannerView) legend() []string {
+	has := map[string]bool{}
+	for _, e := range v.Events {
+		has[e.Calendar] = true
+	}
+	var names []string
+	for _, c := range v.Calendars {
+		if has[c.Title] {
+			names = append(names, c.Title)
+			delete(has, c.Title)
+		}
+	}
+	var rest []string
+	for name := range has {
+		rest = append(rest, name)
+	}
+	sort.Strings(rest)
+	return append(names, rest...)
+}
+
+// monthGrid returns the first day shown in the month grid and its number
+// of week rows.
+func (v PlannerView) monthGrid() (time.Time, int) {
+	first := WeekStart(v.Start, v.WeekStart)
+	next := v.Start.AddDate(0, 1, 0)
+	weeks := 0
+	for d := first; d.Before(next); d = d.AddDate(0, 0, 7) {
+		weeks++
+	}
+	return first, weeks
+}
+
+// plannerSpan is the part of a timed event on day, in minutes from
+// midnight, at least 20 minutes long so short events stay legible.
+func plannerSpan(e calendar.Event, day time.Time) (from, to int) {
+	start, end := eventTimes(e)
+	from, to = 0, 24*60
+	if start.After(day) {
+		from = start.Hour()*60 + start.Minute()
+	}
+	if end.Before(day.AddDate(0, 0, 1)) {
+		to = end.Hour()*60 + end.Minute()
+	}
+	return from, max(to, from+20)
+}
+
+// tint blends a hex color toward white by amount, for event backgrounds.
+func tint(hex string, amount float64) string {
+	r, g, b, ok := parseHex(hex)
+	if !ok {
+		return "#eeeeee"
+	}
+	mix := func(c int) int { return int(math.Round(float64(c) + (255-float64(c))*amount)) }
+	return fmt.Sprintf("#%02x%02x%02x", mix(r), mix(g), mix(b))
+}
+
+// plannerWhen is the start time shown before a title in the month grid:
+// empty for all-day events and for days a timed event only continues on.
+func plannerWhen(e calendar.Event, day time.Time) string {
+	start := DisplayTime(e.StartDate, e.AllDay)
+	if e.AllDay || start.Before(day) {
+		return ""
+	}
+	return locale.Clock(start)
+}
+
+// HTML
+
+const plannerCSS = `@page { size: A4 landscape; margin: 10mm; }
+* { box-sizing: border-box; }
+body { margin: 0; font: 10px/1.3 -apple-system, "Helvetica Neue", Helvetica, Arial, sans-serif; color: #222;
+  -webkit-print-color-adjust: exact; print-color-adjust: exact; }
+h1 { font-size: 16px; margin: 0 0 8px; }
+.event { border-left: 3px solid; border-radius: 3px; padding: 1px 3px; overflow: hidden; }
+.time { color: #555; }
+.legend { margin-top: 8px; }
+.legend span { margin-right: 14px; white-space: nowrap; }
+.swatch { display: inline-block; width: 9px; height: 9px; border-radius: 2px; margin-right: 4px; vertical-align: -1px; }
+.week { display: grid; grid-template-columns: 44px repeat(7, 1fr); border: 1px solid #ccc; }
+.week .head { text-align: center; font-weight: 600; padding: 4px; border-bottom: 1px solid #ccc; border-left: 1px solid #e5e5e5; }
+.week .label { color: #888; padding: 2px 4px; border-bottom: 1px solid #ccc; }
+.week .allday { border-left: 1px solid #e5e5e5; border-bottom: 1px solid #ccc; padding: 2px; }
+.week .allday .event { margin-bottom: 1px; white-space: nowrap; }
+.hours { position: relative; }
+.hours div { position: absolute; right: 4px; color: #888; }
+.day { position: relative; height: 560px; border-left: 1px solid #e5e5e5;
+  background-image: linear-gradient(to bottom, #e5e5e5 1px, transparent 1px); }
+.day .event { position: absolute; border-top: 1px solid #fff; }
+table.month { width: 100%; border-collapse: collapse; table-layout: fixed; }
+.month th { padding: 4px; border: 1px solid #ccc; }
+.month td { height: 100px; vertical-align: top; border: 1px solid #ccc; padding: 2px; }
+.month td.out { color: #aaa; background: #f7f7f7; }
+.month .date { font-weight: 600; text-align: right; }
+.month .event { margin-top: 1px; white-space: nowrap; text-overflow: ellipsis; }
+.month .more { color: #888; margin-top: 1px; }
+`
+
+// WritePlannerHTML writes the planner as a standalone HTML page styled for
+// printing on one landscape sheet.
+func WritePlannerHTML(w io.Writer, v PlannerView) {
+	colors := v.colors()
+	esc := html.EscapeString
+
+	fmt.Fprintf(w, "<!DOCTYPE html>\n<html lang=\"%s\">\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n<style>\n%s</style>\n</head>\n<body>\n",
+		esc(locale.Language), esc(v.title()), plannerCSS)
+	fmt.Fprintf(w, "<h1>%s</h1>\n", esc(v.title()))
+	if v.Month {
+		writePlannerMonthHTML(w, v, colors)
+	} else {
+		writePlannerWeekHTML(w, v, colors)
+	}
+
+	fmt.Fprint(w, `<div class="legend">`)
+	for _, name := range v.legend() {
+		fmt.Fprintf(w, `<span><i class="swatch" style="background:%s"></i>%s</span>`, colors[name], esc(name))
+	}
+	fmt.Fprint(w, "</div>\n</body>\n</html>\n")
+}
+
+func plannerEventStyle(c string) string {
+	return fmt.Sprintf("border-color:%s;background:%s", c, tint(c, 0.8))
+}
+
+func writePlannerWeekHTML(w io.Writer, v PlannerView, colors map[string]string) {
+	esc := html.EscapeString
+	days := layoutWeek(v.Start, v.Events)
+	first, last := weekHourRange(days)
+	span := float64((last - first) * 60)
+	pct := func(min int) float64 { return float64(min-first*60) / span * 100 }
+
+	fmt.Fprintln(w, `<div class="week">`)
+	fmt.Fprintln(w, `<div class="label"></div>`)
+	for _, d := range days {
+		fmt.Fprintf(w, "<div class=\"head\">%s</div>\n", esc(locale.Date(d.date, false)))
+	}
+
+	fmt.Fprintln(w, `<div class="label">all-day</div>`)
+	for _, d := range days {
+		fmt.Fprint(w, `<div class="allday">`)
+		for _, e := range d.allDay {
+			fmt.Fprintf(w, `<div class="event" style="%s">%s</div>`, plannerEventStyle(colors[e.Calendar]), esc(e.Title))
+		}
+		fmt.Fprintln(w, "</div>")
+	}
+
+	fmt.Fprint(w, `<div class="hours">`)
+	for hr := first; hr < last; hr++ {
+		label := weekHourLabel(time.Date(2000, 1, 1, hr, 0, 0, 0, time.Local))
+		fmt.Fprintf(w, `<div style="top:%.2f%%">%s</div>`, pct(hr*60), esc(label))
+	}
+	fmt.Fprintln(w, "</div>")
+
+	for _, d := range days {
+		fmt.Fprintf(w, `<div class="day" style="background-size:100%% %.4f%%">`, 100/float64(last-first))
+		for _, s := range d.segments {
+			from, to := plannerSpan(s.event, d.date)
+			to = min(to, last*60)
+			fmt.Fprintf(w, `<div class="event" style="%s;top:%.2f%%;height:%.2f%%;left:%.2f%%;width:%.2f%%">%s<div class="time">%s</div></div>`,
+				plannerEventStyle(colors[s.event.Calendar]),
+				pct(from), float64(to-from)/span*100,
+				float64(s.lane)/float64(s.lanes)*100, 100/float64(s.lanes),
+				esc(s.event.Title), esc(weekTimeRange(s.event)))
+		}
+		fmt.Fprintln(w, "</div>")
+	}
+	fmt.Fprintln(w, "</div>")
+}
+
+func writePlannerMonthHTML(w io.Writer, v PlannerView, colors map[string]string) {
+	esc := html.EscapeString
+	first, weeks := v.monthGrid()
+	byDay := eventsByDay(v.Events)
+
+	fmt.Fprint(w, "<table class=\"month\">\n<thead><tr>")
+	for i := range 7 {
+		fmt.Fprintf(w, "<th>%s</th>", esc(locale.Weekday(first.AddDate(0, 0, i).Weekday(), false)))
+	}
+	fmt.Fprintln(w, "</tr></thead>\n<tbody>")
+
+	for wk := range weeks {
+		fmt.Fprint(w, "<tr>")
+		for i := range 7 {
+			day := first.AddDate(0, 0, wk*7+i)
+			class := ""
+			if day.Month() != v.Start.Month() {
+				class = ` class="out"`
+			}
+			fmt.Fprintf(w, `<td%s><div class="date">%d</div>`, class, day.Day())
+
+			shown, more := plannerDayEvents(byDay[day.Format(time.DateOnly)], plannerDayLines)
+			for _, j := range shown {
+				e := v.Events[j]
+				when := plannerWhen(e, day)
+				if when != "" {
+					when = `<span class="time">` + esc(when) + "</span> "
+				}
+				fmt.Fprintf(w, `<div class="event" style="%s">%s%s</div>`, plannerEventStyle(colors[e.Calendar]), when, esc(e.Title))
+			}
+			if more > 0 {
+				fmt.Fprintf(w, `<div class="more">+%d more</div>`, more)
+			}
+			fmt.Fprint(w, "</td>")
+		}
+		fmt.Fprintln(w, "</tr>")
+	}
+	fmt.Fprintln(w, "</tbody>\n</table>")
+}
+
+// plannerDayEvents caps a day's events at lines, keeping the last line
+// for "+N more" when some are left out.
+func plannerDayEvents(idx []int, lines int) (shown []int, more int) {
+	if len(idx) <= lines {
+		return idx, 0
+	}
+	return idx[:lines-1], len(idx) - (lines - 1)
+}
+
+// SVG
+
+// WritePlannerSVG writes the planner as a standalone A4 landscape SVG
+// image.
+func WritePlannerSVG(w io.Writer, v PlannerView) {
+	colors := v.colors()
+	esc := html.EscapeString
+
+	fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="-apple-system, Helvetica, Arial, sans-serif" font-size="10">`+"\n",
+		plannerWidth, plannerHeight, plannerWidth, plannerHeight)
+	fmt.Fprintf(w, `  <rect width="%d" height="%d" fill="#fff"/>`+"\n", plannerWidth, plannerHeight)
+	fmt.Fprintf(w, `  <text x="%d" y="%d" font-size="18" font-weight="bold">%s</text>`+"\n", plannerMargin, plannerMargin+14, esc(v.title()))
+
+	if v.Month {
+		writePlannerMonthSVG(w, v, colors)
+	} else {
+		writePlannerWeekSVG(w, v, colors)
+	}
+
+	x := plannerMargin
+	y := plannerHeight - plannerMargin - 6
+	for _, name := range v.legend() {
+		fmt.Fprintf(w, `  <rect x="%d" y="%d" width="10" height="10" rx="2" fill="%s"/>`+"\n", x, y-9, colors[name])
+		fmt.Fprintf(w, `  <text x="%d" y="%d">%s</text>`+"\n", x+14, y, esc(name))
+		x += 14 + runewidth.StringWidth(name)*plannerCharW + 18
+	}
+	fmt.Fprintln(w, "</svg>")
+}
+
+// svgEvent draws an event block: a tinted box with a bar in the calendar's
+// color and up to two lines of text.
+func svgEvent(w io.Writer, x, y, width, height float64, c string, lines ...string) {
+	fmt.Fprintf(w, `  <rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" rx="2" fill="%s"/>`+"\n", x, y, width, height, tint(c, 0.8))
+	fmt.Fprintf(w, `  <rect x="%.1f" y="%.1f" width="3" height="%.1f" fill="%s"/>`+"\n", x, y, height, c)
+	cols := max(int((width-8)/plannerCharW), 1)
+	for i, line := range lines {
+		ty := y + 9 + float64(i)*11
+		if line == "" || ty > y+height {
+			continue
+		}
+		fill := "#222"
+		if i > 0 {
+			fill = "#555"
+		}
+		fmt.Fprintf(w, `  <text x="%.1f" y="%.1f" fill="%s">%s</text>`+"\n", x+6, ty, fill, html.EscapeString(truncate(line, cols)))
+	}
+}
+
+func writePlannerWeekSVG(w io.Writer, v PlannerView, colors map[string]string) {
+	esc := html.EscapeString
+	days := layoutWeek(v.Start, v.Events)
+	first, last := weekHourRange(days)
+
+	allDayRows := 1
+	for _, d := range days {
+		allDayRows = max(allDayRows, len(d.allDay))
+	}
+	left := float64(plannerMargin + plannerGutter)
+	top := float64(plannerMargin + 32)
+	colW := (float64(plannerWidth-plannerMargin) - left) / 7
+	allDayTop := top + plannerHeadH
+	gridTop := allDayTop + float64(allDayRows*14+4)
+	gridBottom := float64(plannerHeight - plannerMargin - plannerLegendH)
+	hourH := (gridBottom - gridTop) / float64(last-first)
+
+	for i, d := range days {
+		x := left + float64(i)*colW
+		fmt.Fprintf(w, `  <text x="%.1f" y="%.1f" text-anchor="middle" font-weight="bold">%s</text>`+"\n", x+colW/2, top+13, esc(locale.Date(d.date, false)))
+		fmt.Fprintf(w, `  <line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="#ddd"/>`+"\n", x, top, x, gridBottom)
+		for j, e := range d.allDay {
+			svgEvent(w, x+1, allDayTop+2+float64(j*14), colW-2, 12, colors[e.Calendar], e.Title)
+		}
+	}
+	fmt.Fprintf(w, `  <line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="#ccc"/>`+"\n", float64(plannerMargin), gridTop, float64(plannerWidth-plannerMargin), gridTop)
+
+	for hr := first; hr < last; hr++ {
+		y := gridTop + float64(hr-first)*hourH
+		label := weekHourLabel(time.Date(2000, 1, 1, hr, 0, 0, 0, time.Local))
+		if hr > first {
+			fmt.Fprintf(w, `  <line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="#eee"/>`+"\n", left, y, float64(plannerWidth-plannerMargin), y)
+		}
+		fmt.Fprintf(w, `  <text x="%.1f" y="%.1f" text-anchor="end" fill="#888">%s</text>`+"\n", left-4, y+10, esc(label))
+	}
+
+	for i, d := range days {
+		x := left + float64(i)*colW
+		for _, s := range d.segments {
+			from, to := plannerSpan(s.event, d.date)
+			to = min(to, last*60)
+			laneW := (colW - 2) / float64(s.lanes)
+			svgEvent(w, x+1+float64(s.lane)*laneW, gridTop+float64(from-first*60)/60*hourH,
+				laneW-1, float64(to-from)/60*hourH-1, colors[s.event.Calendar], s.event.Title, weekTimeRange(s.event))
+		}
+	}
+	fmt.Fprintf(w, `  <rect x="%d" y="%.1f" width="%d" height="%.1f" fill="none" stroke="#ccc"/>`+"\n",
+		plannerMargin, top, plannerWidth-2*plannerMargin, gridBottom-top)
+}
+
+func writePlannerMonthSVG(w io.Writer, v PlannerView, colors map[string]string) {
+	esc := html.EscapeString
+	first, weeks := v.monthGrid()
+	byDay := eventsByDay(v.Events)
+
+	left := float64(plannerMargin)
+	top := float64(plannerMargin + 32)
+	colW := float64(plannerWidth-2*plannerMargin) / 7
+	gridTop := top + plannerHeadH
+	rowH := (float64(plannerHeight-plannerMargin-plannerLegendH) - gridTop) / float64(weeks)
+	lines := max(int((rowH-18)/13), 1)
+
+	for i := range 7 {
+		name := locale.Weekday(first.AddDate(0, 0, i).Weekday(), false)
+		fmt.Fprintf(w, `  <text x="%.1f" y="%.1f" text-anchor="middle" font-weight="bold">%s</text>`+"\n", left+float64(i)*colW+colW/2, top+13, esc(name))
+	}
+
+	for wk := range weeks {
+		for i := range 7 {
+			day := first.AddDate(0, 0, wk*7+i)
+			x, y := left+float64(i)*colW, gridTop+float64(wk)*rowH
+			fill, text := "#fff", "#222"
+			if day.Month() != v.Start.Month() {
+				fill, text = "#f7f7f7", "#aaa"
+			}
+			fmt.Fprintf(w, `  <rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="%s" stroke="#ccc"/>`+"\n", x, y, colW, rowH, fill)
+			fmt.Fprintf(w, `  <text x="%.1f" y="%.1f" text-anchor="end" font-weight="bold" fill="%s">%d</text>`+"\n", x+colW-4, y+12, text, day.Day())
+
+			shown, more := plannerDayEvents(byDay[day.Format(time.DateOnly)], lines)
+			for k, j := range shown {
+				e := v.Events[j]
+				label := e.Title
+				if when := plannerWhen(e, day); when != "" {
+					label = when + " " + label
+				}
+				svgEvent(w, x+2, y+16+float64(k*13), colW-4, 12, colors[e.Calendar], label)
+			}
+			if more > 0 {
+				fmt.Fprintf(w, `  <text x="%.1f" y="%.1f" fill="#888">+%d more</text>`+"\n", x+6, y+16+float64(len(shown)*13)+9, more)
+			}
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package ui
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+func testPlanner(month bool) PlannerView {
+	at := func(day, hour, min int) time.Time { return time.Date(2026, 3, day, hour, min, 0, 0, time.Local) }
+	start := at(2, 0, 0)
+	if month {
+		start = at(1, 0, 0)
+	}
+	return PlannerView{
+		Month:     month,
+		Start:     start,
+		WeekStart: time.Monday,
+		Events: []calendar.Event{
+			{Title: "Offsite", Calendar: "Work", StartDate: at(3, 0, 0), EndDate: at(4, 0, 0), AllDay: true},
+			{Title: "Design <review>", Calendar: "Work", StartDate: at(4, 10, 0), EndDate: at(4, 11, 30)},
+			{Title: "Gym", Calendar: "Gym", StartDate: at(5, 18, 0), EndDate: at(5, 19, 0)},
+		},
+		Calendars: []calendar.Calendar{
+			{Title: "Home", Color: "#FF2968"},
+			{Title: "Work", Color: "#1BADF8FF"},
+		},
+	}
+}
+
+func TestPlannerColorsAndLegend(t *testing.T) {
+	v := testPlanner(false)
+	colors := v.colors()
+	if colors["Work"] != "#1badf8" {
+		t.Errorf("Work = %s, want #1badf8", colors["Work"])
+	}
+	if !strings.HasPrefix(colors["Gym"], "#") {
+		t.Errorf("Gym has no fallback color: %q", colors["Gym"])
+	}
+	// Home has no events, so it is left out; Gym is not in the calendar
+	// list, so it comes last.
+	if got, want := v.legend(), []string{"Work", "Gym"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("legend = %v, want %v", got, want)
+	}
+}
+
+func TestPlannerMonthGrid(t *testing.T) {
+	tests := []struct {
+		month     time.Month
+		weekStart time.Weekday
+		first     time.Time
+		weeks     int
+	}{
+		// 1 March 2026 is a Sunday.
+		{time.March, time.Monday, time.Date(2026, 2, 23, 0, 0, 0, 0, time.Local), 6},
+		{time.March, time.Sunday, time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local), 5},
+		{time.February, time.Sunday, time.Date(2026, 2, 1, 0, 0, 0, 0, time.Local), 4},
+	}
+	for _, tt := range tests {
+		v := PlannerView{Month: true, Start: time.Date(2026, tt.month, 1, 0, 0, 0, 0, time.Local), WeekStart: tt.weekStart}
+		first, weeks := v.monthGrid()
+		if !first.Equal(tt.first) || weeks != tt.weeks {
+			t.Errorf("%v from %v: first %v, %d weeks; want %v, %d", tt.month, tt.weekStart, first, weeks, tt.first, tt.weeks)
+		}
+	}
+}
+
+func TestPlannerDayEvents(t *testing.T) {
+	shown, more := plannerDayEvents([]int{0, 1, 2}, 3)
+	if len(shown) != 3 || more != 0 {
+		t.Errorf("3 in 3 lines: %v, +%d", shown, more)
+	}
+	shown, more = plannerDayEvents([]int{0, 1, 2, 3}, 3)
+	if len(shown) != 2 || more != 2 {
+		t.Errorf("4 in 3 lines: %v, +%d; want 2 shown, +2", shown, more)
+	}
+}
+
+func TestTint(t *testing.T) {
+	if got := tint("#000000", 0.5); got != "#808080" {
+		t.Errorf("tint = %s, want #808080", got)
+	}
+	if got := tint("bogus", 0.5); got != "#eeeeee" {
+		t.Errorf("tint of bad color = %s", got)
+	}
+}
+
+func TestWritePlannerHTML(t *testing.T) {
+	var buf bytes.Buffer
+	WritePlannerHTML(&buf, testPlanner(false))
+	out := buf.String()
+
+	for _, want := range []string{
+		"<!DOCTYPE html>",
+		"@page { size: A4 landscape",
+		"<h1>Mon 02 Mar 2026 – Sun 08 Mar 2026</h1>",
+		`<div class="head">Wed 04 Mar</div>`,
+		"Design &lt;review&gt;",
+		// 10:00–11:30 in an 08:00–19:00 window, widened for the gym.
+		"top:18.18%;height:13.64%",
+		`<div class="time">10:00-11:30</div>`,
+		`style="border-color:#1badf8;background:#d1effe">Offsite</div>`,
+		`<i class="swatch" style="background:#1badf8"></i>Work</span>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("week HTML missing %q", want)
+		}
+	}
+
+	buf.Reset()
+	WritePlannerHTML(&buf, testPlanner(true))
+	out = buf.String()
+	for _, want := range []string{
+		"<h1>March 2026</h1>",
+		"<th>Monday</th>",
+		`<td class="out"><div class="date">23</div>`,
+		`<span class="time">10:00</span> Design &lt;review&gt;`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("month HTML missing %q", want)
+		}
+	}
+	if got := strings.Count(out, "<tr>"); got != 1+6 {
+		t.Errorf("month has %d rows, want header and 6 weeks", got)
+	}
+}
+
+func TestWritePlannerSVG(t *testing.T) {
+	for _, month := range []bool{false, true} {
+		var buf bytes.Buffer
+		WritePlannerSVG(&buf, testPlanner(month))
+		out := buf.String()
+
+		if !strings.HasPrefix(out, `<svg xmlns="http://www.w3.org/2000/svg" width="1123" height="794"`) || !strings.HasSuffix(out, "</svg>\n") {
+			t.Errorf("month=%v: not a standalone SVG", month)
+		}
+		for _, want := range []string{"Design &lt;review&gt;", `fill="#1badf8"`, ">Work</text>", ">Gym</text>"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("month=%v: SVG missing %q", month, want)
+			}
+		}
+	}
+}
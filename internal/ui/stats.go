@@ -0,0 +1,150 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BRO3886/ical/internal/stats"
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+)
+
+// statsBarWidth is the longest bar in the weekday and hour charts.
+const statsBarWidth = 30
+
+// PrintStats prints a stats report as JSON or as a summary followed by
+// tables.
+func PrintStats(r *stats.Report, format string) {
+	if format == "json" {
+		data, _ := json.MarshalIndent(r, "", "  ")
+		fmt.Println(string(data))
+		return
+	}
+	printStatsTable(r, os.Stdout)
+}
+
+func printStatsTable(r *stats.Report, w io.Writer) {
+	bold := color.New(color.Bold)
+
+	bold.Fprintf(w, "%s – %s\n\n", locale.Date(r.From, true), locale.Date(r.To.Add(-time.Nanosecond), true))
+	if r.Events == 0 {
+		fmt.Fprintln(w, "No events found.")
+		return
+	}
+
+	summary := []struct{ label, value string }{
+		{"Events", strconv.Itoa(r.Events)},
+		{"Scheduled", formatHours(r.Hours)},
+		{"Meetings", fmt.Sprintf("%s (%d, avg %s)", formatHours(r.MeetingHours), r.Meetings,
+			CompactDuration(time.Duration(r.AvgMeetingMinutes*float64(time.Minute))))},
+		{"Focus time", formatHours(r.FocusHours)},
+		{"Recurring", fmt.Sprintf("%s (%s) · one-off %s (%s)",
+			percent(r.RecurringHours, r.Hours), formatHours(r.RecurringHours),
+			percent(r.OneOffHours, r.Hours), formatHours(r.OneOffHours))},
+	}
+	for _, s := range summary {
+		bold.Fprintf(w, "%-14s", s.label+":")
+		fmt.Fprintln(w, s.value)
+	}
+
+	var calendars [][]string
+	for _, s := range r.Calendars {
+		calendars = append(calendars, []string{calendarBullet(s.Name) + s.Name, formatHours(s.Hours), strconv.Itoa(s.Events), percent(s.Hours, r.Hours)})
+	}
+	statsSection(w, "By calendar", []string{"Calendar", "Hours", "Events", "Share"}, calendars)
+
+	shares := func(list []stats.Share) [][]string {
+		var rows [][]string
+		for _, s := range list {
+			rows = append(rows, []string{s.Name, formatHours(s.Hours), strconv.Itoa(s.Events)})
+		}
+		return rows
+	}
+	statsSection(w, "By organizer", []string{"Organizer", "Hours", "Meetings"}, shares(r.Organizers))
+	statsSection(w, "By attendee", []string{"Attendee", "Hours", "Meetings"}, shares(r.Attendees))
+
+	var most float64
+	for _, d := range r.Weekdays {
+		most = max(most, d.Hours)
+	}
+	var weekdays [][]string
+	for _, d := range r.Weekdays {
+		weekdays = append(weekdays, []string{locale.Weekday(d.Weekday, false), formatHours(d.Hours), bar(d.Hours, most)})
+	}
+	statsSection(w, "By weekday", []string{"Day", "Hours", ""}, weekdays)
+
+	most = 0
+	for _, h := range r.Hourly {
+		most = max(most, h.Hours)
+	}
+	var hourly [][]string
+	for _, h := range r.Hourly {
+		if h.Hours == 0 {
+			continue
+		}
+		label := locale.Clock(time.Date(2000, 1, 1, h.Hour, 0, 0, 0, time.Local))
+		hourly = append(hourly, []string{label, formatHours(h.Hours), bar(h.Hours, most)})
+	}
+	statsSection(w, "By hour", []string{"Hour", "Hours", ""}, hourly)
+
+	var weeks [][]string
+	for i, wk := range r.Weeks {
+		change := ""
+		if i > 0 {
+			change = formatHours(wk.Change)
+			if wk.Change > 0 {
+				change = "+" + change
+			}
+		}
+		weeks = append(weeks, []string{locale.Date(wk.Start, false), strconv.Itoa(wk.Events), formatHours(wk.Hours), formatHours(wk.MeetingHours), change})
+	}
+	statsSection(w, "Week over week", []string{"Week of", "Events", "Hours", "Meetings", "Change"}, weeks)
+}
+
+// statsSection prints a titled table, or nothing when there are no rows.
+func statsSection(w io.Writer, title string, header []string, rows [][]string) {
+	if len(rows) == 0 {
+		return
+	}
+	fmt.Fprintln(w)
+	color.New(color.Bold).Fprintln(w, title)
+
+	t := tablewriter.NewTable(w,
+		tablewriter.WithConfig(tablewriter.Config{
+			Header: tw.CellConfig{Formatting: tw.CellFormatting{Alignment: tw.AlignCenter}},
+			Row:    tw.CellConfig{Formatting: tw.CellFormatting{Alignment: tw.AlignLeft}},
+		}),
+	)
+	t.Header(header)
+	for _, row := range rows {
+		t.Append(row)
+	}
+	t.Render()
+}
+
+// formatHours prints hours with at most one decimal: "36.5h", "2h".
+func formatHours(h float64) string {
+	return strconv.FormatFloat(math.Round(h*10)/10, 'f', -1, 64) + "h"
+}
+
+func percent(part, whole float64) string {
+	if whole == 0 {
+		return "0%"
+	}
+	return fmt.Sprintf("%.0f%%", part/whole*100)
+}
+
+// bar draws v as a run of blocks scaled so most fills statsBarWidth.
+func bar(v, most float64) string {
+	if most == 0 {
+		return ""
+	}
+	return strings.Repeat("█", int(v/most*statsBarWidth+0.5))
+}
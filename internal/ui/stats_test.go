@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/ical/internal/stats"
+	"github.com/fatih/color"
+)
+
+func TestPrintStatsTable(t *testing.T) {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.Local)
+	r := &stats.Report{
+		From: from, To: from.AddDate(0, 0, 14),
+		Events: 5, Hours: 5, Meetings: 3, MeetingHours: 3, AvgMeetingMinutes: 45,
+		FocusHours: 61.5, RecurringHours: 2, OneOffHours: 3,
+		Calendars:  []stats.Share{{Name: "Work", Hours: 4, Events: 4}, {Name: "Home", Hours: 1, Events: 1}},
+		Organizers: []stats.Share{{Name: "Bob", Hours: 2, Events: 1}},
+		Weekdays:   []stats.WeekdayLoad{{Weekday: time.Monday, Name: "Monday", Hours: 3}, {Weekday: time.Tuesday, Name: "Tuesday", Hours: 1.5}},
+		Hourly:     []stats.HourLoad{{Hour: 9, Hours: 1}, {Hour: 10, Hours: 0}},
+		Weeks: []stats.WeekLoad{
+			{Start: from, Events: 3, Hours: 3.5, MeetingHours: 2.5},
+			{Start: from.AddDate(0, 0, 7), Events: 2, Hours: 1.5, MeetingHours: 0.5, Change: -2},
+		},
+	}
+
+	var buf bytes.Buffer
+	printStatsTable(r, &buf)
+	out := buf.String()
+
+	for _, want := range []string{
+		"Mon 02 Mar 2026 – Sun 15 Mar 2026",
+		"Meetings:     3h (3, avg 45m)",
+		"Focus time:   61.5h",
+		"Recurring:    40% (2h) · one-off 60% (3h)",
+		"│ Work     │ 4h    │ 4      │ 80%   │",
+		"│ Monday  │ 3h    │ ██████████████████████████████ │",
+		"│ Tuesday │ 1.5h  │ ███████████████                │",
+		"│ 09:00 │ 1h    │",
+		"│ Mon 09 Mar │ 2      │ 1.5h  │ 0.5h     │ -2h    │",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "By attendee") || strings.Contains(out, "10:00") {
+		t.Errorf("empty sections or hours shown:\n%s", out)
+	}
+
+	buf.Reset()
+	printStatsTable(&stats.Report{From: from, To: from.AddDate(0, 0, 1)}, &buf)
+	if !strings.Contains(buf.String(), "No events found.") {
+		t.Errorf("empty report:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,105 @@
+package ui
+
+// Columns of the event table, in display order.
+const (
+	colNum = iota
+	colDate
+	colTime
+	colTitle
+	colCalendar
+	colLocation
+	colDuration
+	numTableCols
+)
+
+var tableHeaders = [numTableCols]string{"#", "Date", "Time", "Title", "Calendar", "Location", "Duration"}
+
+const (
+	// minTitleWidth and minLocationWidth are the narrowest the flexible
+	// columns get before a lower-priority column is dropped instead.
+	minTitleWidth    = 20
+	minLocationWidth = 12
+
+	// pipedTitleWidth and pipedLocationWidth cap the flexible columns
+	// when there is no width to fit, keeping piped output stable.
+	pipedTitleWidth    = 40
+	pipedLocationWidth = 25
+)
+
+// tableDropOrder lists the columns given up, in order, when the table
+// doesn't fit.
+var tableDropOrder = []int{colLocation, colDuration}
+
+// tableLayout is how the event table fits a width: which columns are shown
+// and how wide the title and location may be (0 = as wide as needed).
+type tableLayout struct {
+	show     [numTableCols]bool
+	title    int
+	location int
+}
+
+// planTable fits columns with the given natural (widest cell) widths into
+// width. The fixed columns keep their width; title and location share the
+// rest in proportion to their content, and location then duration are
+// dropped when that would squeeze either below its minimum. A width of 0
+// keeps every column with the piped caps.
+func planTable(natural [numTableCols]int, width int) tableLayout {
+	var l tableLayout
+	for c := range l.show {
+		l.show[c] = true
+	}
+	if width <= 0 {
+		l.title, l.location = pipedTitleWidth, pipedLocationWidth
+		return l
+	}
+
+	minTitle := min(natural[colTitle], minTitleWidth)
+	minLocation := min(natural[colLocation], minLocationWidth)
+
+	var avail int
+	for i := 0; ; i++ {
+		// Each cell is padded by a space on both sides and followed by a
+		// border; the table also has a leading border.
+		avail = width - 1
+		for c, on := range l.show {
+			if !on {
+				continue
+			}
+			avail -= 3
+			if c != colTitle && c != colLocation {
+				avail -= natural[c]
+			}
+		}
+
+		need, least := natural[colTitle], minTitle
+		if l.show[colLocation] {
+			need += natural[colLocation]
+			least += minLocation
+		}
+		switch {
+		case avail >= need:
+			return l
+		case avail >= least && !l.show[colLocation]:
+			l.title = avail
+			return l
+		case avail >= least:
+			l.title = max(avail*natural[colTitle]/need, minTitle)
+			l.location = avail - l.title
+			if l.location < minLocation {
+				l.location = minLocation
+				l.title = avail - minLocation
+			}
+			return l
+		}
+
+		if i == len(tableDropOrder) {
+			break
+		}
+		l.show[tableDropOrder[i]] = false
+	}
+
+	// Too narrow for anything comfortable: the title gets what's left,
+	// and the table overflows rather than lose the title entirely.
+	l.title = max(avail, minTitleWidth/2)
+	return l
+}
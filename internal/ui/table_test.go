@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/fatih/color"
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+func TestPlanTable(t *testing.T) {
+	// #, Date, Time, Title, Calendar, Location, Duration. The fixed
+	// columns take 1+10+13+8+8 = 40 columns plus 22 for borders and
+	// padding, leaving width-62 for title and location.
+	natural := [numTableCols]int{1, 10, 13, 60, 8, 30, 8}
+	all := [numTableCols]bool{true, true, true, true, true, true, true}
+	noLocation := all
+	noLocation[colLocation] = false
+	titleOnly := noLocation
+	titleOnly[colDuration] = false
+
+	tests := []struct {
+		name         string
+		width        int
+		wantShow     [numTableCols]bool
+		wantTitle    int
+		wantLocation int
+	}{
+		{"piped keeps the old caps", 0, all, 40, 25},
+		{"room for everything", 200, all, 0, 0},
+		{"exact fit", 152, all, 0, 0},
+		{"shared in proportion", 122, all, 40, 20},
+		{"location at its minimum", 95, all, 21, 12},
+		{"location dropped", 85, noLocation, 26, 0},
+		{"duration dropped", 68, titleOnly, 20, 0},
+		{"title squeezed", 60, titleOnly, 12, 0},
+		{"overflow keeps some title", 40, titleOnly, 10, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := planTable(natural, tt.width)
+			if l.show != tt.wantShow {
+				t.Errorf("show = %v, want %v", l.show, tt.wantShow)
+			}
+			if l.title != tt.wantTitle || l.location != tt.wantLocation {
+				t.Errorf("title, location = %d, %d; want %d, %d", l.title, l.location, tt.wantTitle, tt.wantLocation)
+			}
+		})
+	}
+}
+
+func TestEventsTableWidth(t *testing.T) {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+	defer SetWidth(0)
+
+	at := time.Date(2026, 3, 17, 9, 0, 0, 0, time.Local)
+	title := strings.Repeat("Quarterly planning ", 4) + "review"
+	events := []calendar.Event{
+		{Title: title, Calendar: "Work", Location: "Conference Room B, Building 4", StartDate: at, EndDate: at.Add(time.Hour), Recurring: true},
+		{Title: "Standup", Calendar: "Work", StartDate: at.Add(2 * time.Hour), EndDate: at.Add(150 * time.Minute)},
+	}
+
+	for _, width := range []int{200, 120, 100, 80, 70} {
+		SetWidth(width)
+		var buf bytes.Buffer
+		printEventsTable(events, &buf)
+		out := buf.String()
+		for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+			if w := runewidth.StringWidth(line); w > width {
+				t.Errorf("width %d: line is %d wide: %q", width, w, line)
+			}
+		}
+		if width == 200 && !strings.Contains(out, title+" ↻") {
+			t.Errorf("width 200: full title not shown:\n%s", out)
+		}
+		if width <= 80 && strings.Contains(out, "LOCATION") {
+			t.Errorf("width %d: location column not dropped:\n%s", width, out)
+		}
+	}
+}
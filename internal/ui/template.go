@@ -0,0 +1,167 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+// templatePrefix marks an output format that carries a Go text/template,
+// as in -o 'template={{.Title}}'.
+const templatePrefix = "template="
+
+// TemplateFormat returns the output format for a template.
+func TemplateFormat(text string) string {
+	return templatePrefix + text
+}
+
+// templateText extracts the template from an output format.
+func templateText(format string) (string, bool) {
+	if !strings.HasPrefix(format, templatePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(format, templatePrefix), true
+}
+
+// IsTemplateFormat reports whether format is a template output format.
+func IsTemplateFormat(format string) bool {
+	_, ok := templateText(format)
+	return ok
+}
+
+// ValidateTemplate parses the template in format, if any, so syntax errors
+// surface before a command does any work.
+func ValidateTemplate(format string) error {
+	text, ok := templateText(format)
+	if !ok {
+		return nil
+	}
+	if strings.TrimSpace(text) == "" {
+		return fmt.Errorf("empty output template")
+	}
+	_, err := parseTemplate(text)
+	return err
+}
+
+func parseTemplate(text string) (*template.Template, error) {
+	t, err := template.New("output").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output template: %w", err)
+	}
+	return t, nil
+}
+
+// TemplateEvent is the data passed to event templates: the event itself,
+// plus its 1-based row number as shown in the table's # column. An all-day
+// event's dates are already moved to the display zone with DisplayTime, so
+// fmtTime and local keep the date the table shows.
+type TemplateEvent struct {
+	calendar.Event
+	Index int
+}
+
+// templateFuncs are available in every output template. Times are shown in
+// the display zone unless inZone says otherwise, matching the table output.
+var templateFuncs = template.FuncMap{
+	// {{.StartDate | fmtTime "15:04"}}
+	"fmtTime": func(layout string, t time.Time) string {
+		return t.In(DisplayLocation()).Format(layout)
+	},
+	// {{(.StartDate | local).Hour}}
+	"local": func(t time.Time) time.Time {
+		return t.In(DisplayLocation())
+	},
+	// {{.StartDate | inZone "Asia/Tokyo" | fmtTime "15:04 MST"}}
+	"inZone": func(name string, t time.Time) (time.Time, error) {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			return t, err
+		}
+		return t.In(loc), nil
+	},
+	// {{duration .StartDate .EndDate}} → "1h30m"
+	"duration": func(start, end time.Time) string {
+		return CompactDuration(end.Sub(start))
+	},
+	// {{until .StartDate}} → "15m", negative once the time has passed
+	"until": func(t time.Time) string {
+		return CompactDuration(time.Until(t))
+	},
+	// {{.Title | truncate 20}}
+	"truncate": func(n int, s string) string {
+		return truncate(s, n)
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	// {{join ", " .Names}}
+	"join": func(sep string, elems []string) string {
+		return strings.Join(elems, sep)
+	},
+}
+
+// CompactDuration renders d as "2d3h", "1h30m" or "15m", rounded to the
+// minute, with a leading "-" when negative.
+func CompactDuration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+	d = d.Round(time.Minute)
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	h := d / time.Hour
+	m := (d % time.Hour) / time.Minute
+
+	var b strings.Builder
+	b.WriteString(sign)
+	if days > 0 {
+		fmt.Fprintf(&b, "%dd", days)
+	}
+	if h > 0 {
+		fmt.Fprintf(&b, "%dh", h)
+	}
+	if m > 0 || (days == 0 && h == 0) {
+		fmt.Fprintf(&b, "%dm", m)
+	}
+	return b.String()
+}
+
+// executeTemplate renders items one per line. A missing trailing newline is
+// added so a template like '{{.Title}}' prints one event per line. The
+// first error stops the output and is returned, since the template was
+// already validated and a failure here is a data problem (e.g. a bad zone
+// name).
+func executeTemplate[T any](format string, items []T, w io.Writer) error {
+	text, _ := templateText(format)
+	t, err := parseTemplate(text)
+	if err != nil {
+		return err
+	}
+	newline := !strings.HasSuffix(text, "\n")
+	for _, item := range items {
+		if err := t.Execute(w, item); err != nil {
+			return fmt.Errorf("failed to render template: %w", err)
+		}
+		if newline {
+			fmt.Fprintln(w)
+		}
+	}
+	return nil
+}
+
+func printEventsTemplate(events []calendar.Event, format string, w io.Writer) error {
+	items := make([]TemplateEvent, len(events))
+	for i, e := range events {
+		if e.AllDay {
+			e.StartDate = DisplayTime(e.StartDate, true)
+			e.EndDate = DisplayTime(e.EndDate, true)
+		}
+		items[i] = TemplateEvent{Event: e, Index: i + 1}
+	}
+	return executeTemplate(format, items, w)
+}
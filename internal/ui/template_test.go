@@ -0,0 +1,151 @@
+package ui
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+)
+
+func TestValidateTemplate(t *testing.T) {
+	tests := []struct {
+		format  string
+		wantErr bool
+	}{
+		{"table", false},
+		{"json", false},
+		{"template={{.Title}}", false},
+		{`template={{.StartDate | fmtTime "15:04"}}`, false},
+		{"template=", true},
+		{"template={{.Title", true},
+		{"template={{nope .Title}}", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			err := ValidateTemplate(tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTemplate(%q) error = %v, wantErr %v", tt.format, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPrintEventsTemplate(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local)
+	events := []calendar.Event{
+		{Title: "Standup", StartDate: start, EndDate: start.Add(15 * time.Minute), Calendar: "Work"},
+		{Title: "Quarterly planning review", StartDate: start.Add(2 * time.Hour), EndDate: start.Add(3*time.Hour + 30*time.Minute), Calendar: "Work"},
+	}
+
+	tests := []struct {
+		name string
+		tmpl string
+		want string
+	}{
+		{
+			name: "time and duration",
+			tmpl: `{{.Index}} {{.StartDate | fmtTime "15:04"}} {{.Title}} ({{duration .StartDate .EndDate}})`,
+			want: "1 09:00 Standup (15m)\n2 11:00 Quarterly planning review (1h30m)\n",
+		},
+		{
+			name: "truncate and upper",
+			tmpl: `{{.Title | truncate 10 | upper}}`,
+			want: "STANDUP\nQUARTER...\n",
+		},
+		{
+			name: "trailing newline kept",
+			tmpl: "{{.Calendar}}\n",
+			want: "Work\nWork\n",
+		},
+		{
+			name: "zone conversion",
+			tmpl: `{{.StartDate | inZone "UTC" | fmtTime "2006-01-02"}}`,
+			want: "2026-03-02\n2026-03-02\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := printEventsTemplate(events, TemplateFormat(tt.tmpl), &buf); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrintEventsTemplateAllDay(t *testing.T) {
+	london, err1 := time.LoadLocation("Europe/London")
+	la, err2 := time.LoadLocation("America/Los_Angeles")
+	if err1 != nil || err2 != nil {
+		t.Skip("no tzdata")
+	}
+	defer func(l *time.Location) { time.Local = l }(time.Local)
+	time.Local = london
+	defer SetDisplayLocation(nil)
+
+	start := time.Date(2026, 3, 17, 0, 0, 0, 0, london)
+	events := []calendar.Event{
+		{Title: "Holiday", AllDay: true, StartDate: start, EndDate: start.AddDate(0, 0, 1)},
+		{Title: "Call", StartDate: start.Add(9 * time.Hour), EndDate: start.Add(10 * time.Hour)},
+	}
+	format := TemplateFormat(`{{.StartDate | fmtTime "2006-01-02 15:04"}} {{(.StartDate | local).Day}} {{.Title}}`)
+
+	for _, loc := range []*time.Location{london, la} {
+		SetDisplayLocation(loc)
+		var buf bytes.Buffer
+		if err := printEventsTemplate(events, format, &buf); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		call := start.Add(9 * time.Hour).In(loc)
+		want := "2026-03-17 00:00 17 Holiday\n" + call.Format("2006-01-02 15:04") + " 17 Call\n"
+		if got := buf.String(); got != want {
+			t.Errorf("%s: got %q, want %q", loc, got, want)
+		}
+	}
+}
+
+func TestPrintEventsTemplateError(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local)
+	events := []calendar.Event{
+		{Title: "Standup", StartDate: start, EndDate: start.Add(15 * time.Minute)},
+		{Title: "Review", StartDate: start.Add(time.Hour), EndDate: start.Add(2 * time.Hour)},
+	}
+
+	var buf bytes.Buffer
+	err := printEventsTemplate(events, TemplateFormat(`{{.StartDate | inZone "Nowhere/Zone"}}`), &buf)
+	if err == nil {
+		t.Fatal("expected an error for an unknown zone")
+	}
+	if got := buf.String(); got != "" {
+		t.Errorf("output after error = %q, want none", got)
+	}
+}
+
+func TestCompactDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "0m"},
+		{15 * time.Minute, "15m"},
+		{time.Hour, "1h"},
+		{90 * time.Minute, "1h30m"},
+		{26 * time.Hour, "1d2h"},
+		{-45 * time.Minute, "-45m"},
+		{29 * time.Second, "0m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := CompactDuration(tt.d); got != tt.want {
+				t.Errorf("CompactDuration(%v) = %q, want %q", tt.d, got, tt.want)
+			}
+		})
+	}
+}
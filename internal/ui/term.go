@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/charmbracelet/x/term"
+)
+
+// defaultWidth is used when stdout is not a terminal and COLUMNS is unset.
+const defaultWidth = 100
+
+// widthOverride is the --width flag; see SetWidth.
+var widthOverride int
+
+// SetWidth fixes the output width, overriding COLUMNS and the terminal
+// size. 0 restores detection.
+func SetWidth(n int) {
+	widthOverride = n
+}
+
+// TerminalWidth returns the width of the terminal on stdout. --width and
+// COLUMNS take precedence so output can be sized when piped.
+func TerminalWidth() int {
+	if w := detectedWidth(); w > 0 {
+		return w
+	}
+	return defaultWidth
+}
+
+// detectedWidth returns --width, COLUMNS or the terminal's width, or 0
+// when output is piped and no width was given.
+func detectedWidth() int {
+	if widthOverride > 0 {
+		return widthOverride
+	}
+	if v, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && v > 0 {
+		return v
+	}
+	if w, _, err := term.GetSize(os.Stdout.Fd()); err == nil && w > 0 {
+		return w
+	}
+	return 0
+}
+
+// IsTerminal reports whether stdout is a terminal.
+func IsTerminal() bool {
+	return term.IsTerminal(os.Stdout.Fd())
+}
@@ -0,0 +1,388 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/fatih/color"
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+const (
+	// weekSlotMinutes is the height of one grid row.
+	weekSlotMinutes = 30
+	// weekFirstHour and weekLastHour bound the grid unless events fall
+	// outside them.
+	weekFirstHour = 8
+	weekLastHour  = 18
+	// weekMinColumn is the narrowest day column before the grid overflows.
+	weekMinColumn = 8
+)
+
+// weekGutter is the width of the time column, including its padding.
+func weekGutter() int {
+	return locale.ClockWidth() + 1
+}
+
+// WeekView is a seven-day grid: one column per day, one row per half hour.
+type WeekView struct {
+	Start  time.Time // first day, at local midnight
+	Events []calendar.Event
+	Colors CalendarColors
+	Now    time.Time
+	Width  int
+}
+
+// weekSegment is the part of a timed event that falls on one day, placed
+// in a lane so overlapping events sit side by side.
+type weekSegment struct {
+	event     calendar.Event
+	startSlot int // slot index from midnight
+	endSlot   int // exclusive
+	lane      int
+	lanes     int // lanes in this event's overlap cluster
+}
+
+type weekDay struct {
+	date     time.Time
+	allDay   []calendar.Event
+	segments []*weekSegment
+}
+
+// RenderWeek draws the week grid.
+func RenderWeek(w io.Writer, v WeekView) {
+	days := layoutWeek(v.Start, v.Events)
+	first, last := weekHourRange(days)
+
+	col := (v.Width-weekGutter())/7 - 1
+	if col < weekMinColumn {
+		col = weekMinColumn
+	}
+
+	bold := color.New(color.Bold)
+	end := v.Start.AddDate(0, 0, 6)
+	bold.Fprintf(w, "%s – %s\n", locale.Date(v.Start, false), locale.Date(end, true))
+
+	// Header
+	todayIdx := dayIndex(v.Start, v.Now)
+	cells := make([]string, 7)
+	for i, d := range days {
+		label := locale.ShortDate(d.date)
+		if col >= 10 {
+			label = locale.Date(d.date, false)
+		}
+		cell := fit(label, col)
+		if i == todayIdx {
+			cell = color.New(color.Bold, color.ReverseVideo).Sprint(cell)
+		} else {
+			cell = bold.Sprint(cell)
+		}
+		cells[i] = cell
+	}
+	fmt.Fprintln(w, weekLine(strings.Repeat(" ", weekGutter()), cells))
+	fmt.Fprintln(w, weekRule(col))
+
+	// All-day rows
+	allDayRows := 0
+	for _, d := range days {
+		allDayRows = max(allDayRows, len(d.allDay))
+	}
+	for r := 0; r < allDayRows; r++ {
+		for i, d := range days {
+			cells[i] = strings.Repeat(" ", col)
+			if r < len(d.allDay) {
+				e := d.allDay[r]
+				cells[i] = v.Colors.For(e.Calendar).Sprint(fit("■ "+e.Title, col))
+			}
+		}
+		gutter := strings.Repeat(" ", weekGutter())
+		if r == 0 {
+			gutter = fit("all", weekGutter())
+		}
+		fmt.Fprintln(w, weekLine(gutter, cells))
+	}
+	if allDayRows > 0 {
+		fmt.Fprintln(w, weekRule(col))
+	}
+
+	// Timed rows
+	nowSlot := -1
+	if todayIdx >= 0 {
+		nowSlot = (v.Now.Hour()*60 + v.Now.Minute()) / weekSlotMinutes
+	}
+	red := color.New(color.FgRed, color.Bold)
+	for s := first * 60 / weekSlotMinutes; s < last*60/weekSlotMinutes; s++ {
+		gutter := strings.Repeat(" ", weekGutter())
+		if s*weekSlotMinutes%60 == 0 {
+			// Wall-clock label, so a DST change on the first day does not
+			// shift every row by an hour.
+			y, m, d := v.Start.Date()
+			hour := time.Date(y, m, d, 0, s*weekSlotMinutes, 0, 0, v.Start.Location())
+			gutter = fit(weekHourLabel(hour), weekGutter())
+		}
+		if s == nowSlot {
+			gutter = red.Sprint(fit(locale.Clock(v.Now), weekGutter()-1)) + red.Sprint("▸")
+		}
+		for i, d := range days {
+			cells[i] = renderWeekCell(d, s, col, v.Colors, i == todayIdx && s == nowSlot)
+		}
+		fmt.Fprintln(w, weekLine(gutter, cells))
+	}
+}
+
+// layoutWeek sorts events into the seven days and assigns lanes.
+func layoutWeek(start time.Time, events []calendar.Event) []weekDay {
+	days := make([]weekDay, 7)
+	for i := range days {
+		days[i].date = start.AddDate(0, 0, i)
+	}
+
+	for _, e := range events {
+		es, ee := eventTimes(e)
+		for i := range days {
+			dayStart := days[i].date
+			dayEnd := dayStart.AddDate(0, 0, 1)
+			if !overlapsDay(es, ee, dayStart, dayEnd) {
+				continue
+			}
+			if e.AllDay {
+				days[i].allDay = append(days[i].allDay, e)
+				continue
+			}
+			startMin := 0
+			if !es.Before(dayStart) {
+				startMin = es.Hour()*60 + es.Minute()
+			}
+			endMin := 24 * 60
+			if ee.Before(dayEnd) {
+				endMin = ee.Hour()*60 + ee.Minute()
+			}
+			seg := &weekSegment{
+				event:     e,
+				startSlot: startMin / weekSlotMinutes,
+				endSlot:   (endMin + weekSlotMinutes - 1) / weekSlotMinutes,
+			}
+			if seg.endSlot <= seg.startSlot {
+				seg.endSlot = seg.startSlot + 1
+			}
+			days[i].segments = append(days[i].segments, seg)
+		}
+	}
+
+	for i := range days {
+		assignLanes(days[i].segments)
+	}
+	return days
+}
+
+// assignLanes places overlapping segments side by side. Segments are
+// grouped into clusters of transitively overlapping events; every segment
+// in a cluster shares the cluster's lane count so columns line up.
+func assignLanes(segs []*weekSegment) {
+	sort.SliceStable(segs, func(i, j int) bool {
+		if segs[i].startSlot != segs[j].startSlot {
+			return segs[i].startSlot < segs[j].startSlot
+		}
+		return segs[i].endSlot > segs[j].endSlot
+	})
+
+	var cluster []*weekSegment
+	var laneEnds []int
+	clusterEnd := -1
+	flush := func() {
+		for _, s := range cluster {
+			s.lanes = len(laneEnds)
+		}
+		cluster = nil
+		laneEnds = nil
+	}
+
+	for _, s := range segs {
+		if s.startSlot >= clusterEnd {
+			flush()
+		}
+		lane := -1
+		for l, end := range laneEnds {
+			if end <= s.startSlot {
+				lane = l
+				break
+			}
+		}
+		if lane < 0 {
+			lane = len(laneEnds)
+			laneEnds = append(laneEnds, 0)
+		}
+		laneEnds[lane] = s.endSlot
+		s.lane = lane
+		cluster = append(cluster, s)
+		clusterEnd = max(clusterEnd, s.endSlot)
+	}
+	flush()
+}
+
+// weekHourRange widens the default working-hours window to cover every
+// timed event in the week.
+func weekHourRange(days []weekDay) (first, last int) {
+	first, last = weekFirstHour, weekLastHour
+	for _, d := range days {
+		for _, s := range d.segments {
+			first = min(first, s.startSlot*weekSlotMinutes/60)
+			last = max(last, (s.endSlot*weekSlotMinutes+59)/60)
+		}
+	}
+	return first, last
+}
+
+// renderWeekCell draws one day column for one slot. Each lane shows a
+// colored bar for the event's extent, the title on its first row and the
+// time range on its second.
+func renderWeekCell(d weekDay, slot, width int, colors CalendarColors, nowRow bool) string {
+	var active []*weekSegment
+	for _, s := range d.segments {
+		if slot >= s.startSlot && slot < s.endSlot {
+			active = append(active, s)
+		}
+	}
+
+	blank := strings.Repeat(" ", width)
+	if nowRow {
+		blank = color.New(color.FgRed).Sprint(strings.Repeat("─", width))
+	}
+	if len(active) == 0 {
+		return blank
+	}
+
+	lanes := active[0].lanes
+	maxLanes := max(1, width/4)
+	shown := lanes
+	if lanes > maxLanes {
+		shown = maxLanes
+	}
+	laneWidth := width / shown
+
+	var b strings.Builder
+	used := 0
+	for l := 0; l < shown; l++ {
+		lw := laneWidth
+		if l == shown-1 {
+			lw = width - used
+		}
+		used += lw
+
+		if lanes > shown && l == shown-1 {
+			hidden := 0
+			for _, s := range active {
+				if s.lane >= l {
+					hidden++
+				}
+			}
+			if hidden > 0 {
+				b.WriteString(fit(fmt.Sprintf("+%d", hidden), lw))
+				continue
+			}
+		}
+
+		var seg *weekSegment
+		for _, s := range active {
+			if s.lane == l {
+				seg = s
+				break
+			}
+		}
+		if seg == nil {
+			if nowRow {
+				b.WriteString(color.New(color.FgRed).Sprint(strings.Repeat("─", lw)))
+			} else {
+				b.WriteString(strings.Repeat(" ", lw))
+			}
+			continue
+		}
+
+		text := ""
+		switch slot - seg.startSlot {
+		case 0:
+			text = seg.event.Title
+		case 1:
+			text = weekTimeRange(seg.event)
+		}
+		cell := colors.For(seg.event.Calendar).Sprint(fit("▌"+text, lw))
+		if slot == seg.startSlot {
+			cell = color.New(color.Bold).Sprint(cell)
+		}
+		b.WriteString(cell)
+	}
+	return b.String()
+}
+
+func weekTimeRange(e calendar.Event) string {
+	start, end := eventTimes(e)
+	return locale.Clock(start) + "-" + locale.Clock(end)
+}
+
+// weekHourLabel labels a full hour in the gutter: "09:00" or "9am".
+func weekHourLabel(t time.Time) string {
+	if locale.Clock12 {
+		return t.Format("3pm")
+	}
+	return t.Format("15:04")
+}
+
+// WeekStart returns midnight on the most recent start day at or before t.
+func WeekStart(t time.Time, start time.Weekday) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := (int(day.Weekday()) - int(start) + 7) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// overlapsDay reports whether [start, end) touches [dayStart, dayEnd).
+// Zero-length events count for the day they start on.
+func overlapsDay(start, end, dayStart, dayEnd time.Time) bool {
+	if !end.After(start) {
+		return !start.Before(dayStart) && start.Before(dayEnd)
+	}
+	return start.Before(dayEnd) && end.After(dayStart)
+}
+
+// dayIndex returns which of the seven days t falls on, or -1.
+func dayIndex(start, t time.Time) int {
+	for i := 0; i < 7; i++ {
+		d := start.AddDate(0, 0, i)
+		if !t.Before(d) && t.Before(d.AddDate(0, 0, 1)) {
+			return i
+		}
+	}
+	return -1
+}
+
+func weekLine(gutter string, cells []string) string {
+	var b strings.Builder
+	b.WriteString(gutter)
+	for _, c := range cells {
+		b.WriteString("│")
+		b.WriteString(c)
+	}
+	return b.String()
+}
+
+func weekRule(col int) string {
+	var b strings.Builder
+	b.WriteString(strings.Repeat("─", weekGutter()))
+	for i := 0; i < 7; i++ {
+		b.WriteString("┼")
+		b.WriteString(strings.Repeat("─", col))
+	}
+	return b.String()
+}
+
+// fit truncates s to width display cells and pads it to exactly width.
+func fit(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	s = runewidth.Truncate(s, width, "…")
+	return runewidth.FillRight(s, width)
+}
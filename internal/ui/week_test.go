@@ -0,0 +1,158 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BRO3886/go-eventkit/calendar"
+	"github.com/fatih/color"
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+func weekTestEvents(monday time.Time) []calendar.Event {
+	at := func(day, hour, min int) time.Time {
+		return monday.AddDate(0, 0, day).Add(time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute)
+	}
+	return []calendar.Event{
+		{Title: "Standup", StartDate: at(0, 9, 0), EndDate: at(0, 9, 30), Calendar: "Work"},
+		{Title: "Design review", StartDate: at(0, 10, 0), EndDate: at(0, 11, 30), Calendar: "Work"},
+		{Title: "Dentist", StartDate: at(0, 11, 0), EndDate: at(0, 12, 0), Calendar: "Home"},
+		{Title: "Offsite", StartDate: at(2, 0, 0), EndDate: at(4, 0, 0), AllDay: true, Calendar: "Work"},
+		{Title: "Late deploy", StartDate: at(4, 21, 0), EndDate: at(4, 22, 0), Calendar: "Work"},
+	}
+}
+
+func TestLayoutWeek(t *testing.T) {
+	monday := time.Date(2026, 3, 2, 0, 0, 0, 0, time.Local)
+	days := layoutWeek(monday, weekTestEvents(monday))
+
+	if got := len(days[0].segments); got != 3 {
+		t.Fatalf("monday has %d timed segments, want 3", got)
+	}
+	lanes := map[string][2]int{}
+	for _, s := range days[0].segments {
+		lanes[s.event.Title] = [2]int{s.lane, s.lanes}
+	}
+	want := map[string][2]int{
+		"Standup":       {0, 1},
+		"Design review": {0, 2},
+		"Dentist":       {1, 2},
+	}
+	for title, w := range want {
+		if lanes[title] != w {
+			t.Errorf("%s lane/lanes = %v, want %v", title, lanes[title], w)
+		}
+	}
+
+	// The all-day offsite covers Wednesday and Thursday only.
+	for i, wantN := range []int{0, 0, 1, 1, 0, 0, 0} {
+		if got := len(days[i].allDay); got != wantN {
+			t.Errorf("day %d has %d all-day events, want %d", i, got, wantN)
+		}
+	}
+
+	first, last := weekHourRange(days)
+	if first != 8 || last != 22 {
+		t.Errorf("hour range = %d-%d, want 8-22", first, last)
+	}
+}
+
+func TestRenderWeek(t *testing.T) {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	monday := time.Date(2026, 3, 2, 0, 0, 0, 0, time.Local)
+	var buf bytes.Buffer
+	RenderWeek(&buf, WeekView{
+		Start:  monday,
+		Events: weekTestEvents(monday),
+		Colors: CalendarColors{},
+		Now:    monday.Add(9*time.Hour + 40*time.Minute),
+		Width:  120,
+	})
+	out := buf.String()
+
+	for _, want := range []string{"Mon 02 Mar", "Sun 08 Mar", "■ Offsite", "▌Standup", "▌Desig…", "▌Dentist", "09:40▸", "21:00"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	for i, line := range strings.Split(strings.TrimRight(out, "\n"), "\n") {
+		if w := runewidth.StringWidth(line); i > 0 && w > 120 {
+			t.Errorf("line %d is %d wide, want <= 120: %q", i, w, line)
+		}
+	}
+}
+
+func TestRenderWeekDSTLabels(t *testing.T) {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+	SetLocale(DefaultLocale())
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("no tzdata:", err)
+	}
+	SetDisplayLocation(ny)
+	defer SetDisplayLocation(nil)
+	// Clocks go forward on the first day of this week.
+	sunday := time.Date(2026, 3, 8, 0, 0, 0, 0, ny)
+	standup := time.Date(2026, 3, 8, 9, 0, 0, 0, ny)
+	var buf bytes.Buffer
+	RenderWeek(&buf, WeekView{
+		Start:  sunday,
+		Events: []calendar.Event{{Title: "Standup", StartDate: standup, EndDate: standup.Add(30 * time.Minute), Calendar: "Work"}},
+		Colors: CalendarColors{},
+		Now:    sunday.AddDate(0, 0, 10),
+		Width:  120,
+	})
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if strings.Contains(line, "▌Standup") {
+			if !strings.HasPrefix(strings.TrimSpace(line), "09:00") {
+				t.Errorf("standup row labelled %q, want 09:00", line)
+			}
+			return
+		}
+	}
+	t.Errorf("standup not rendered:\n%s", buf.String())
+}
+
+func TestHexColor(t *testing.T) {
+	for _, s := range []string{"#1BADF8", "1badf8", "#1BADF8FF"} {
+		if hexColor(s) == nil {
+			t.Errorf("hexColor(%q) = nil", s)
+		}
+	}
+	for _, s := range []string{"", "#12345", "#GGGGGG", "blue"} {
+		if hexColor(s) != nil {
+			t.Errorf("hexColor(%q) != nil", s)
+		}
+	}
+}
+
+func TestWeekStart(t *testing.T) {
+	// Wednesday 4 March 2026, mid-afternoon.
+	wed := time.Date(2026, 3, 4, 15, 30, 0, 0, time.Local)
+
+	tests := []struct {
+		name  string
+		start time.Weekday
+		want  time.Time
+	}{
+		{"monday start", time.Monday, time.Date(2026, 3, 2, 0, 0, 0, 0, time.Local)},
+		{"sunday start", time.Sunday, time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local)},
+		{"start day itself", time.Wednesday, time.Date(2026, 3, 4, 0, 0, 0, 0, time.Local)},
+		{"thursday start", time.Thursday, time.Date(2026, 2, 26, 0, 0, 0, 0, time.Local)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WeekStart(wed, tt.start); !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}